@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/subtle"
 	"database/sql"
 	"encoding/base64"
 	"encoding/json"
@@ -18,6 +19,9 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/akimdev15/melongo/auth-server/internal/database"
 	"github.com/akimdev15/melongo/auth-server/proto"
@@ -47,8 +51,12 @@ type SpotifyTokenResponse struct {
 
 const gRPCPORT = "50001"
 
+// serviceSecretKey is the gRPC metadata key of the secret shared with the other services (SERVICE_SECRET in config.env)
+const serviceSecretKey = "x-service-secret"
+
 func (app *apiConfig) grpcListen() {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", gRPCPORT))
+	// Only the services on the same host call the auth server
+	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%s", gRPCPORT))
 	if err != nil {
 		slog.Error("Failed to listen for grpc", "error", err)
 		os.Exit(1)
@@ -75,56 +83,113 @@ func (authServer *AuthServer) AuthenticateUser(ctx context.Context, req *proto.A
 		return nil, err
 	}
 
-	tokenRefreshed := false
-	if userToken.ExpireTime.Before(time.Now().UTC()) {
-		slog.Info("Access-Token expired. Getting a new token...")
-		refreshToken, err := RefreshToken(userToken.RefreshToken, ctx)
-		if err != nil {
-			slog.Error("Error refreshing token", "error", err)
-			return nil, err
-		}
+	userToken, tokenRefreshed, err := authServer.refreshTokenIfExpired(ctx, userToken)
+	if err != nil {
+		return nil, err
+	}
 
-		// Update user token with the refresh token
-		newExpireTime := time.Now().UTC().Add(time.Duration(refreshToken.ExpiresIn) * time.Second)
-		userToken.AccessToken = refreshToken.AccessToken
+	res := &proto.AuthenticateResponse{
+		AccessToken: userToken.AccessToken,
+		UserID:      userToken.ID,
+		IsRefreshed: tokenRefreshed,
+	}
 
-		// Refresh token returned from the response might be empty. In this case use the existing refresh token (According to the documentation)
-		if refreshToken.RefreshToken == "" {
-			refreshToken.RefreshToken = userToken.RefreshToken
-		}
+	return res, nil
+}
 
-		// Asynchronously update to the DB
-		errCh := make(chan error)
-		go func() {
-			err = authServer.DB.UpdateToken(ctx, database.UpdateTokenParams{
-				AccessToken:  refreshToken.AccessToken,
-				RefreshToken: refreshToken.RefreshToken,
-				ExpireTime:   newExpireTime,
-				UpdatedAt:    time.Now(),
-				ID:           userToken.ID,
-			})
-			// send err or nil if successful to the channel
-			errCh <- err
-		}()
-
-		err = <-errCh
+// GetUserToken returns a valid access token of the user with the userID.
+// Only for the playlist server scheduler and the admin backfill which make requests to spotify for the user
+// in the background. The caller must send SERVICE_SECRET in the metadata
+func (authServer *AuthServer) GetUserToken(ctx context.Context, req *proto.GetUserTokenRequest) (*proto.GetUserTokenResponse, error) {
+	if err := checkServiceSecret(ctx); err != nil {
+		slog.Error("Rejected GetUserToken without the service secret", "userID", req.GetUserID())
+		return nil, err
+	}
 
-		if err != nil {
-			slog.Error("Error updating the user token with the refresh token", "error", err)
-			return nil, err
-		}
+	userID := req.GetUserID()
+	if userID == "" {
+		return nil, errors.New("UserID is empty")
+	}
 
-		slog.Info("Successfully updated the refresh token")
-		tokenRefreshed = true
+	userToken, err := authServer.DB.GetUserTokenByID(ctx, userID)
+	if err != nil {
+		slog.Error("Error getting user token from the DB", "userID", userID, "error", err)
+		return nil, err
 	}
 
-	res := &proto.AuthenticateResponse{
+	userToken, tokenRefreshed, err := authServer.refreshTokenIfExpired(ctx, userToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetUserTokenResponse{
 		AccessToken: userToken.AccessToken,
-		UserID:      userToken.ID,
 		IsRefreshed: tokenRefreshed,
+	}, nil
+}
+
+// checkServiceSecret makes sure the caller is one of the services with the shared secret.
+// Every call is rejected when SERVICE_SECRET isn't set
+func checkServiceSecret(ctx context.Context) error {
+	secret := os.Getenv("SERVICE_SECRET")
+	if secret == "" {
+		return status.Error(codes.PermissionDenied, "SERVICE_SECRET is not configured")
 	}
 
-	return res, nil
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(serviceSecretKey)
+	if len(values) == 0 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(secret)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid service secret")
+	}
+	return nil
+}
+
+// refreshTokenIfExpired gets a new access token with the refresh token if the access token expired and saves it in the DB
+// returns the user token with the valid access token and whether it was refreshed
+func (authServer *AuthServer) refreshTokenIfExpired(ctx context.Context, userToken database.UserToken) (database.UserToken, bool, error) {
+	if !userToken.ExpireTime.Before(time.Now().UTC()) {
+		return userToken, false, nil
+	}
+
+	slog.Info("Access-Token expired. Getting a new token...")
+	refreshToken, err := RefreshToken(userToken.RefreshToken, ctx)
+	if err != nil {
+		slog.Error("Error refreshing token", "error", err)
+		return userToken, false, err
+	}
+
+	// Update user token with the refresh token
+	newExpireTime := time.Now().UTC().Add(time.Duration(refreshToken.ExpiresIn) * time.Second)
+	userToken.AccessToken = refreshToken.AccessToken
+
+	// Refresh token returned from the response might be empty. In this case use the existing refresh token (According to the documentation)
+	if refreshToken.RefreshToken == "" {
+		refreshToken.RefreshToken = userToken.RefreshToken
+	}
+
+	// Asynchronously update to the DB
+	errCh := make(chan error)
+	go func() {
+		err = authServer.DB.UpdateToken(ctx, database.UpdateTokenParams{
+			AccessToken:  refreshToken.AccessToken,
+			RefreshToken: refreshToken.RefreshToken,
+			ExpireTime:   newExpireTime,
+			UpdatedAt:    time.Now(),
+			ID:           userToken.ID,
+		})
+		// send err or nil if successful to the channel
+		errCh <- err
+	}()
+
+	err = <-errCh
+
+	if err != nil {
+		slog.Error("Error updating the user token with the refresh token", "error", err)
+		return userToken, false, err
+	}
+
+	slog.Info("Successfully updated the refresh token")
+	return userToken, true, nil
 }
 
 // AuthorizeUser handles authorization callback from spotify.
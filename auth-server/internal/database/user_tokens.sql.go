@@ -83,6 +83,25 @@ func (q *Queries) GetUserTokenByAccessToken(ctx context.Context, accessToken str
 	return i, err
 }
 
+const getUserTokenByID = `-- name: GetUserTokenByID :one
+SELECT id, api_key, access_token, refresh_token, expire_time, created_at, updated_at FROM user_tokens WHERE id = $1
+`
+
+func (q *Queries) GetUserTokenByID(ctx context.Context, id string) (UserToken, error) {
+	row := q.db.QueryRowContext(ctx, getUserTokenByID, id)
+	var i UserToken
+	err := row.Scan(
+		&i.ID,
+		&i.ApiKey,
+		&i.AccessToken,
+		&i.RefreshToken,
+		&i.ExpireTime,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateToken = `-- name: UpdateToken :exec
 UPDATE user_tokens
 SET access_token = $1,
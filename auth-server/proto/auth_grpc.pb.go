@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	AuthService_AuthorizeUser_FullMethodName    = "/proto.AuthService/AuthorizeUser"
 	AuthService_AuthenticateUser_FullMethodName = "/proto.AuthService/AuthenticateUser"
+	AuthService_GetUserToken_FullMethodName     = "/proto.AuthService/GetUserToken"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -36,6 +37,10 @@ type AuthServiceClient interface {
 	// When user is requesting for something like playlist, will always have
 	// to go through this authentication method
 	AuthenticateUser(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	// Returns a valid access token of the user (refreshed if expired).
+	// Used by other services to make spotify requests on behalf of the user
+	// when the user isn't the one making the request (ex. background jobs)
+	GetUserToken(ctx context.Context, in *GetUserTokenRequest, opts ...grpc.CallOption) (*GetUserTokenResponse, error)
 }
 
 type authServiceClient struct {
@@ -64,6 +69,15 @@ func (c *authServiceClient) AuthenticateUser(ctx context.Context, in *Authentica
 	return out, nil
 }
 
+func (c *authServiceClient) GetUserToken(ctx context.Context, in *GetUserTokenRequest, opts ...grpc.CallOption) (*GetUserTokenResponse, error) {
+	out := new(GetUserTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetUserToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility
@@ -77,6 +91,10 @@ type AuthServiceServer interface {
 	// When user is requesting for something like playlist, will always have
 	// to go through this authentication method
 	AuthenticateUser(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	// Returns a valid access token of the user (refreshed if expired).
+	// Used by other services to make spotify requests on behalf of the user
+	// when the user isn't the one making the request (ex. background jobs)
+	GetUserToken(context.Context, *GetUserTokenRequest) (*GetUserTokenResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -90,6 +108,9 @@ func (UnimplementedAuthServiceServer) AuthorizeUser(context.Context, *AuthCallba
 func (UnimplementedAuthServiceServer) AuthenticateUser(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateUser not implemented")
 }
+func (UnimplementedAuthServiceServer) GetUserToken(context.Context, *GetUserTokenRequest) (*GetUserTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserToken not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 
 // UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -139,6 +160,24 @@ func _AuthService_AuthenticateUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetUserToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetUserToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetUserToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetUserToken(ctx, req.(*GetUserTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,6 +193,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthenticateUser",
 			Handler:    _AuthService_AuthenticateUser_Handler,
 		},
+		{
+			MethodName: "GetUserToken",
+			Handler:    _AuthService_GetUserToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
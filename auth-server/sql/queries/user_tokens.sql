@@ -16,3 +16,6 @@ SET access_token = $1,
     expire_time = $3,
     updated_at = $4
 WHERE id = $5;
+
+-- name: GetUserTokenByID :one
+SELECT * FROM user_tokens WHERE id = $1;
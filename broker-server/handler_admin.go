@@ -0,0 +1,395 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+)
+
+type UpdateResolvedTrackRequest struct {
+	MissedTitle  string `json:"missedTitle"`
+	MissedArtist string `json:"missedArtist"`
+	SpotifyURI   string `json:"spotifyURI"`
+}
+
+type ReportWrongMatchRequest struct {
+	URI string `json:"uri"`
+}
+
+// ImportResolvedTrackRow is a single mapping of the JSON import (same as the JSON export)
+type ImportResolvedTrackRow struct {
+	MissedTitle  string `json:"missedTitle"`
+	MissedArtist string `json:"missedArtist"`
+	SpotifyURI   string `json:"spotifyURI"`
+}
+
+func handleListResolvedTracks(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	reportedOnly := r.URL.Query().Get("reportedOnly") == "true"
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ListResolvedTracks(ctx, &proto.ListResolvedTracksRequest{
+		AccessToken:  accessToken,
+		ReportedOnly: reportedOnly,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleListResolvedTracks", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func handleUpdateResolvedTrack(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload UpdateResolvedTrackRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	if requestPayload.MissedTitle == "" || requestPayload.MissedArtist == "" || requestPayload.SpotifyURI == "" {
+		http.Error(w, "missedTitle, missedArtist and spotifyURI are required", http.StatusBadRequest)
+		return
+	}
+
+	// Looks up the spotify track and rewrites the tracks of the past dates
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 10*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.UpdateResolvedTrack(ctx, &proto.UpdateResolvedTrackRequest{
+		AccessToken:  accessToken,
+		MissedTitle:  requestPayload.MissedTitle,
+		MissedArtist: requestPayload.MissedArtist,
+		SpotifyURI:   requestPayload.SpotifyURI,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleUpdateResolvedTrack", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func handleDeleteResolvedTrack(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	missedTitle := r.URL.Query().Get("missedTitle")
+	missedArtist := r.URL.Query().Get("missedArtist")
+	if missedTitle == "" || missedArtist == "" {
+		slog.Error("Missing missedTitle or missedArtist parameter")
+		http.Error(w, "Missing missedTitle or missedArtist parameter", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 10*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.DeleteResolvedTrack(ctx, &proto.DeleteResolvedTrackRequest{
+		AccessToken:  accessToken,
+		MissedTitle:  missedTitle,
+		MissedArtist: missedArtist,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleDeleteResolvedTrack", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleReportWrongMatch can be called by any user from the track of the playlist
+func handleReportWrongMatch(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload ReportWrongMatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil || requestPayload.URI == "" {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ReportWrongMatch(ctx, &proto.ReportWrongMatchRequest{
+		AccessToken: accessToken,
+		Uri:         requestPayload.URI,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleReportWrongMatch", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleImportResolvedTracks imports the mappings from the CSV (missed_title, missed_artist, spotify_uri columns)
+// or JSON body and streams them to the playlist server.
+// Query params: format (csv or json, default is from the Content-Type), dryRun and overwrite
+func handleImportResolvedTracks(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+		if strings.Contains(r.Header.Get("Content-Type"), "json") {
+			format = "json"
+		}
+	}
+
+	var rows []ImportResolvedTrackRow
+	var err error
+	switch format {
+	case "csv":
+		rows, err = parseImportCSV(r.Body)
+	case "json":
+		err = json.NewDecoder(r.Body).Decode(&rows)
+	default:
+		http.Error(w, "Invalid format parameter", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		slog.Error("Error parsing the import file", "format", format, "error", err)
+		http.Error(w, fmt.Sprintf("Invalid %s file: %v", format, err), http.StatusBadRequest)
+		return
+	}
+	if len(rows) == 0 {
+		http.Error(w, "No mappings to import", http.StatusBadRequest)
+		return
+	}
+
+	// Every row is looked up on spotify
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 5*time.Minute)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	stream, err := client.ImportResolvedTracks(ctx)
+	if err != nil {
+		slog.Error("Error opening the import stream", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	dryRun := r.URL.Query().Get("dryRun") == "true"
+	overwrite := r.URL.Query().Get("overwrite") == "true"
+	for _, row := range rows {
+		err := stream.Send(&proto.ImportResolvedTrackRow{
+			AccessToken:  accessToken,
+			DryRun:       dryRun,
+			Overwrite:    overwrite,
+			MissedTitle:  row.MissedTitle,
+			MissedArtist: row.MissedArtist,
+			SpotifyURI:   row.SpotifyURI,
+		})
+		if err != nil {
+			slog.Error("Error sending the imported row", "error", err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		slog.Error("Error in handleImportResolvedTracks", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// parseImportCSV reads the mappings from the CSV with the header row. Other columns (ex. from the export) are ignored
+func parseImportCSV(body io.Reader) ([]ImportResolvedTrackRow, error) {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	// spreadsheet might use uri instead of spotify_uri
+	if _, ok := columns["spotify_uri"]; !ok {
+		if i, ok := columns["uri"]; ok {
+			columns["spotify_uri"] = i
+		}
+	}
+	for _, name := range []string{"missed_title", "missed_artist", "spotify_uri"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing %s column", name)
+		}
+	}
+
+	column := func(record []string, name string) string {
+		if i := columns[name]; i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	var rows []ImportResolvedTrackRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, ImportResolvedTrackRow{
+			MissedTitle:  column(record, "missed_title"),
+			MissedArtist: column(record, "missed_artist"),
+			SpotifyURI:   column(record, "spotify_uri"),
+		})
+	}
+
+	return rows, nil
+}
+
+// handleExportResolvedTracks downloads the mappings as a CSV or JSON file (format query param)
+func handleExportResolvedTracks(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "json" {
+		http.Error(w, "Invalid format parameter", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 10*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ExportResolvedTracks(ctx, &proto.ExportResolvedTracksRequest{
+		AccessToken:  accessToken,
+		Format:       format,
+		ReportedOnly: r.URL.Query().Get("reportedOnly") == "true",
+	})
+
+	if err != nil {
+		slog.Error("Error in handleExportResolvedTracks", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", response.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"resolved_tracks.%s\"", response.Format))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(response.Data); err != nil {
+		slog.Error("Error writing the export", "error", err)
+	}
+}
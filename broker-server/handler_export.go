@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+)
+
+// Formats supported by handleExportPlaylist
+var playlistExportFormats = map[string]bool{
+	"csv":  true,
+	"json": true,
+	"m3u":  true,
+	"xspf": true,
+}
+
+// handleExportPlaylist downloads every track of the playlist as a CSV, JSON, M3U or XSPF file (format query param).
+// The file is written as the pages arrive from the playlist server so that large playlists aren't held in memory
+func handleExportPlaylist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	playlistID := r.PathValue("id")
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	if !playlistExportFormats[format] {
+		http.Error(w, "Invalid format parameter", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 2*time.Minute)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	stream, err := client.ExportPlaylist(ctx, &proto.ExportPlaylistRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		PlaylistID:  playlistID,
+		Format:      format,
+	})
+	if err != nil {
+		slog.Error("Error in handleExportPlaylist", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Errors before the first chunk can still be reported with the status code
+	chunk, err := stream.Recv()
+	if err != nil {
+		slog.Error("Error in handleExportPlaylist", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", chunk.ContentType)
+	w.Header().Set("Content-Disposition", contentDisposition(chunk.FileName))
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	for {
+		if _, err := w.Write(chunk.Data); err != nil {
+			slog.Error("Error writing the export", "error", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		} else if err != nil {
+			// The response is already partially written, so the download is cut short
+			slog.Error("Error in handleExportPlaylist", "playlistID", playlistID, "error", err)
+			return
+		}
+	}
+}
+
+// contentDisposition returns the attachment header of the file. Playlist names can be in Korean, so the name is sent
+// as UTF-8 in filename* with an ASCII fallback in filename for the clients which don't support it
+func contentDisposition(fileName string) string {
+	fallback := strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e || r == '"' || r == '\\' {
+			return '_'
+		}
+		return r
+	}, fileName)
+	return fmt.Sprintf("attachment; filename=\"%s\"; filename*=UTF-8''%s", fallback, url.PathEscape(fileName))
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+)
+
+type AddFavoriteArtistRequest struct {
+	ArtistName string `json:"artistName"`
+}
+
+type ImportFavoriteArtistsRequest struct {
+	Source string `json:"source"`
+}
+
+type FavoriteArtistsPlaylistRequest struct {
+	PlaylistID string `json:"playlistID"`
+	Source     string `json:"source"`
+	Date       string `json:"date"`
+	Genre      string `json:"genre"`
+}
+
+func handleListFavoriteArtists(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ListFavoriteArtists(ctx, &proto.ListFavoriteArtistsRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleListFavoriteArtists", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleAddFavoriteArtist searches the artist name on spotify and adds it to the favorite artists
+func handleAddFavoriteArtist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload AddFavoriteArtistRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil || requestPayload.ArtistName == "" {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 10*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.AddFavoriteArtist(ctx, &proto.AddFavoriteArtistRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		ArtistName:  requestPayload.ArtistName,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleAddFavoriteArtist", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func handleRemoveFavoriteArtist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	artistID := r.URL.Query().Get("artistID")
+	if artistID == "" {
+		slog.Error("Missing artistID parameter")
+		http.Error(w, "Missing artistID parameter", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.RemoveFavoriteArtist(ctx, &proto.RemoveFavoriteArtistRequest{
+		AccessToken:     accessToken,
+		UserID:          userID,
+		SpotifyArtistID: artistID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleRemoveFavoriteArtist", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleImportFavoriteArtists adds the followed or top artists on spotify (source) to the favorite artists
+func handleImportFavoriteArtists(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload ImportFavoriteArtistsRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil || requestPayload.Source == "" {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 30*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ImportFavoriteArtists(ctx, &proto.ImportFavoriteArtistsRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		Source:      requestPayload.Source,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleImportFavoriteArtists", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleFavoriteArtistsPlaylist adds the chart entries (top100, genre or new_releases) by the favorite artists to the playlist
+func handleFavoriteArtistsPlaylist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload FavoriteArtistsPlaylistRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil || requestPayload.PlaylistID == "" || requestPayload.Source == "" {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 10*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.CreateFavoriteArtistsPlaylist(ctx, &proto.CreateFavoriteArtistsPlaylistRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		PlaylistID:  requestPayload.PlaylistID,
+		Source:      requestPayload.Source,
+		Date:        requestPayload.Date,
+		Genre:       requestPayload.Genre,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleFavoriteArtistsPlaylist", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MergePlaylistsRequest struct {
+	PlaylistIDs      []string `json:"playlistIDs"`
+	Mode             string   `json:"mode"`     // union (default) or intersection
+	DedupeBy         string   `json:"dedupeBy"` // uri (default) or isrc
+	TargetPlaylistID string   `json:"targetPlaylistID"`
+	TargetName       string   `json:"targetName"`
+	Replace          bool     `json:"replace"`
+	DryRun           bool     `json:"dryRun"`
+}
+
+type DedupePlaylistRequest struct {
+	DedupeBy string `json:"dedupeBy"`
+	DryRun   bool   `json:"dryRun"`
+}
+
+// handleMergePlaylists writes the union or the intersection of the playlists to a new or an existing playlist
+func handleMergePlaylists(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload MergePlaylistsRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	if len(requestPayload.PlaylistIDs) < 2 {
+		http.Error(w, "At least two playlists are required", http.StatusBadRequest)
+		return
+	}
+
+	// Every track of every playlist is read from spotify
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 2*time.Minute)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.MergePlaylists(ctx, &proto.MergePlaylistsRequest{
+		AccessToken:      accessToken,
+		UserID:           userID,
+		PlaylistIDs:      requestPayload.PlaylistIDs,
+		Mode:             requestPayload.Mode,
+		DedupeBy:         requestPayload.DedupeBy,
+		TargetPlaylistID: requestPayload.TargetPlaylistID,
+		TargetName:       requestPayload.TargetName,
+		Replace:          requestPayload.Replace,
+		DryRun:           requestPayload.DryRun,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleMergePlaylists", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleDedupePlaylist removes the duplicate tracks of the playlist in place
+func handleDedupePlaylist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	playlistID := r.PathValue("id")
+
+	var requestPayload DedupePlaylistRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil && !errors.Is(err, io.EOF) {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", time.Minute)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.DedupePlaylist(ctx, &proto.DedupePlaylistRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		PlaylistID:  playlistID,
+		DedupeBy:    requestPayload.DedupeBy,
+		DryRun:      requestPayload.DryRun,
+	})
+
+	if status.Code(err) == codes.FailedPrecondition {
+		// The playlist changed while the duplicates were found so nothing was removed
+		slog.Error("Error in handleDedupePlaylist", "error", err)
+		http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+		return
+	} else if err != nil {
+		slog.Error("Error in handleDedupePlaylist", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UndoOperationRequest struct {
+	ID int32 `json:"id"`
+}
+
+// handleUndoOperation removes the tracks added by the operation. The last operation is undone when the id is missing
+func handleUndoOperation(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload UndoOperationRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil && !errors.Is(err, io.EOF) {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 30*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.UndoOperation(ctx, &proto.UndoOperationRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		Id:          requestPayload.ID,
+	})
+
+	if status.Code(err) == codes.FailedPrecondition {
+		// The playlist changed since the operation so the tracks weren't removed
+		slog.Error("Error in handleUndoOperation", "error", err)
+		http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+		return
+	} else if err != nil {
+		slog.Error("Error in handleUndoOperation", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/akimdev15/melongo/broker/proto"
@@ -26,18 +27,30 @@ type CreatePlaylistPayload struct {
 }
 
 type MelonTop100Request struct {
-	PlaylistID string `json:"playlistID"`
-	Date       string `json:"date"`
-}
-
-type MelonTop100Response struct {
-	Status string `json:"status"`
+	PlaylistID            string   `json:"playlistID"`
+	Date                  string   `json:"date"`
+	IncludePendingMatches bool     `json:"includePendingMatches"`
+	FromRank              int32    `json:"fromRank"`
+	ToRank                int32    `json:"toRank"`
+	Limit                 int32    `json:"limit"`
+	ExcludeArtists        []string `json:"excludeArtists"`
+	Async                 bool     `json:"async"`
+	FreshOnly             bool     `json:"freshOnly"`
+	FreshWithinDays       int32    `json:"freshWithinDays"`
 }
 
 type ResolveMissedTracksRequest struct {
 	ResolvedTracks []*proto.ResolvedTrack `json:"resolvedTracks"`
 }
 
+type ReviewPendingMatchRequest struct {
+	MelonTitle  string `json:"melonTitle"`
+	MelonArtist string `json:"melonArtist"`
+	URI         string `json:"uri"`
+	Action      string `json:"action"`
+	SpotifyURI  string `json:"spotifyURI"`
+}
+
 func handleCreatePlaylist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
 	fmt.Println("HandleCreatePlaylist")
 	// connect to server
@@ -94,10 +107,18 @@ func handleCreatePlaylist(w http.ResponseWriter, r *http.Request, accessToken st
 }
 
 func handleMelonTop100(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
-	// connect to server
-	conn, err := grpc.Dial("localhost:50002", grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	var payload MelonTop100Request
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	// Adding the whole chart takes a few spotify requests unless it runs in the background
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 30*time.Second)
 	if err != nil {
-		slog.Error("Error during gRPC dial", "error", err)
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 	defer func(conn *grpc.ClientConn) {
@@ -107,35 +128,71 @@ func handleMelonTop100(w http.ResponseWriter, r *http.Request, accessToken strin
 		}
 	}(conn)
 
-	// create client
-	client := proto.NewPlaylistServiceClient(conn)
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
-	var payload MelonTop100Request
-	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
-		slog.Error("Error decoding payload", "error", err)
+	response, err := client.CreateMelonTop100(ctx, &proto.CreateMelonTop100Request{
+		AccessToken:           accessToken,
+		UserID:                userID,
+		PlaylistID:            payload.PlaylistID,
+		Date:                  payload.Date,
+		IncludePendingMatches: payload.IncludePendingMatches,
+		FromRank:              payload.FromRank,
+		ToRank:                payload.ToRank,
+		Limit:                 payload.Limit,
+		ExcludeArtists:        payload.ExcludeArtists,
+		Async:                 payload.Async,
+		FreshOnly:             payload.FreshOnly,
+		FreshWithinDays:       payload.FreshWithinDays,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleMelonTop100", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 
-	response, err := client.CreateMelonTop100(ctx, &proto.CreateMelonTop100Request{
-		AccessToken: accessToken,
-		UserID:      userID,
-		PlaylistID:  payload.PlaylistID,
-		Date:        payload.Date,
-	})
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		return
+	}
+}
 
+func handleGetMelonTop100Job(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	jobID := r.URL.Query().Get("jobID")
+	if jobID == "" {
+		slog.Error("Missing jobID parameter")
+		http.Error(w, "Missing jobID parameter", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
 	if err != nil {
-		// TODO - need to return errorJSON
-		slog.Error("Error creating playlist", "error", err)
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
-	slog.Info("Melon Top 100 Response: ", "response", response)
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
 
-	var responsePayload MelonTop100Response
-	responsePayload.Status = response.Status
+	response, err := client.GetCreateMelonTop100Job(ctx, &proto.GetCreateMelonTop100JobRequest{
+		JobID:  jobID,
+		UserID: userID,
+	})
 
-	err = writeJSON(w, http.StatusOK, responsePayload)
+	if err != nil {
+		slog.Error("Error in handleGetMelonTop100Job", "error", err)
+		http.Error(w, "Job not found", http.StatusNotFound)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
 	if err != nil {
 		slog.Error("Error writing JSON", "error", err)
 		return
@@ -170,10 +227,7 @@ func handleSaveMelonTop100DB(w http.ResponseWriter, r *http.Request, accessToken
 		return
 	}
 
-	var responsePayload MelonTop100Response
-	responsePayload.Status = response.Status
-
-	err = writeJSON(w, http.StatusOK, responsePayload)
+	err = writeJSON(w, http.StatusOK, response)
 	if err != nil {
 		slog.Error("Error writing JSON", "error", err)
 		return
@@ -223,9 +277,64 @@ func handleGetMissedTracks(w http.ResponseWriter, r *http.Request, accessToken s
 	}
 }
 
+func handleSuggestCandidates(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	date := r.URL.Query().Get("date")
+	if date == "" {
+		slog.Error("Missing date parameter")
+		http.Error(w, "Missing date parameter", http.StatusBadRequest)
+		return
+	}
+
+	var limit int
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		parsedLimit, err := strconv.Atoi(limitParam)
+		if err != nil {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+		limit = parsedLimit
+	}
+
+	// Searching spotify for every missed track takes longer than the other requests
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 30*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.SuggestCandidates(ctx, &proto.SuggestCandidatesRequest{
+		AccessToken: accessToken,
+		Date:        date,
+		Limit:       int32(limit),
+	})
+
+	if err != nil {
+		slog.Error("Error in handleSuggestCandidates", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
 func handleResolveMissedTracks(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
 
-	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	// Small batches are resolved synchronously which requires the spotify lookup for each track
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 30*time.Second)
 	if err != nil {
 		slog.Error("Error during gRPC connection setup", "error", err)
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
@@ -248,9 +357,19 @@ func handleResolveMissedTracks(w http.ResponseWriter, r *http.Request, accessTok
 		return
 	}
 
+	// The exact URI skips the search and the confidence check, so only the admins can paste the song link
+	for _, track := range requestPayload.ResolvedTracks {
+		if track.SpotifyURI != "" && !isAdminUser(userID) {
+			slog.Error("Non admin user tried to resolve by spotifyURI", "userID", userID)
+			http.Error(w, "Forbidden. Only admins can resolve by spotifyURI", http.StatusForbidden)
+			return
+		}
+	}
+
 	response, err := client.ResolveMissedTracks(ctx, &proto.ResolveMissedTracksRequest{
 		AccessToken:    accessToken,
 		ResolvedTracks: requestPayload.ResolvedTracks,
+		UserID:         userID,
 	})
 
 	if err != nil {
@@ -267,7 +386,14 @@ func handleResolveMissedTracks(w http.ResponseWriter, r *http.Request, accessTok
 	}
 }
 
-func handleGetPlaylists(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+func handleGetResolveJob(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	jobID := r.URL.Query().Get("jobID")
+	if jobID == "" {
+		slog.Error("Missing jobID parameter")
+		http.Error(w, "Missing jobID parameter", http.StatusBadRequest)
+		return
+	}
+
 	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
 	if err != nil {
 		slog.Error("Error during gRPC connection setup", "error", err)
@@ -283,12 +409,49 @@ func handleGetPlaylists(w http.ResponseWriter, r *http.Request, accessToken stri
 
 	defer cancel()
 
-	response, err := client.GetUserPlaylists(ctx, &proto.GetUserPlaylistsRequest{
+	response, err := client.GetResolveJob(ctx, &proto.GetResolveJobRequest{
+		JobID:  jobID,
+		UserID: userID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleGetResolveJob", "error", err)
+		http.Error(w, "Job not found", http.StatusNotFound)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleListPendingMatches returns the low confidence matches waiting for the review. date is optional
+func handleListPendingMatches(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ListPendingMatches(ctx, &proto.ListPendingMatchesRequest{
 		AccessToken: accessToken,
+		Date:        r.URL.Query().Get("date"),
 	})
 
 	if err != nil {
-		slog.Error("Error in handleGetPlaylists", "error", err)
+		slog.Error("Error in handleListPendingMatches", "error", err)
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
@@ -301,14 +464,55 @@ func handleGetPlaylists(w http.ResponseWriter, r *http.Request, accessToken stri
 	}
 }
 
-func handleGetPlaylistTracks(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
-	tracksEnpoint := r.URL.Query().Get("endpoint")
-	if tracksEnpoint == "" {
-		slog.Error("Missing tracksEnpoint parameter")
-		http.Error(w, "Missing tracksEnpoint parameter", http.StatusBadRequest)
+// handleReviewPendingMatch approves, rejects or replaces (with spotifyURI) the pending match
+func handleReviewPendingMatch(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload ReviewPendingMatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil || requestPayload.URI == "" || requestPayload.Action == "" {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
 		return
 	}
 
+	// Replacing looks up the spotify track
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 10*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ReviewPendingMatch(ctx, &proto.ReviewPendingMatchRequest{
+		AccessToken: accessToken,
+		MelonTitle:  requestPayload.MelonTitle,
+		MelonArtist: requestPayload.MelonArtist,
+		Uri:         requestPayload.URI,
+		Action:      requestPayload.Action,
+		SpotifyURI:  requestPayload.SpotifyURI,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleReviewPendingMatch", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func handleGetPlaylists(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
 	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
 	if err != nil {
 		slog.Error("Error during gRPC connection setup", "error", err)
@@ -324,13 +528,52 @@ func handleGetPlaylistTracks(w http.ResponseWriter, r *http.Request, accessToken
 
 	defer cancel()
 
-	response, err := client.GetUserPlaylistTracks(ctx, &proto.GetUserPlaylistTracksRequest{
-		AccessToken:    accessToken,
-		TracksEndpoint: tracksEnpoint,
+	response, err := client.GetUserPlaylists(ctx, &proto.GetUserPlaylistsRequest{
+		AccessToken: accessToken,
 	})
 
 	if err != nil {
-		slog.Error("Error in handleGetPlaylistTracks", "error", err)
+		slog.Error("Error in handleGetPlaylists", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleGetPlaylist returns the playlist with its owner, followers and every track
+func handleGetPlaylist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	playlistID := r.PathValue("id")
+
+	// Every page of the tracks is fetched from spotify
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 30*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.GetPlaylist(ctx, &proto.GetPlaylistRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		PlaylistID:  playlistID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleGetPlaylist", "error", err)
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
@@ -345,6 +588,11 @@ func handleGetPlaylistTracks(w http.ResponseWriter, r *http.Request, accessToken
 
 // ---------- HELPER FUNCTIONS ----------
 func connectToGRPCServer(address string) (*grpc.ClientConn, proto.PlaylistServiceClient, context.Context, context.CancelFunc, error) {
+	return connectToGRPCServerWithTimeout(address, time.Second)
+}
+
+// connectToGRPCServerWithTimeout is same as connectToGRPCServer but for the requests that take longer than a second
+func connectToGRPCServerWithTimeout(address string, timeout time.Duration) (*grpc.ClientConn, proto.PlaylistServiceClient, context.Context, context.CancelFunc, error) {
 	// Connect to the gRPC server
 	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
 	if err != nil {
@@ -356,7 +604,7 @@ func connectToGRPCServer(address string) (*grpc.ClientConn, proto.PlaylistServic
 	client := proto.NewPlaylistServiceClient(conn)
 
 	// Create a context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 
 	return conn, client, ctx, cancel, nil
 }
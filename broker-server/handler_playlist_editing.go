@@ -0,0 +1,243 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UpdatePlaylistDetailsRequest struct {
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	ClearDescription bool   `json:"clearDescription"`
+	Visibility       string `json:"visibility"` // public, private or collaborative
+}
+
+type PlaylistTrackPosition struct {
+	URI      string `json:"uri"`
+	Position int32  `json:"position"`
+}
+
+type RemovePlaylistTracksRequest struct {
+	URIs       []string                `json:"uris"`   // every occurrence is removed
+	Tracks     []PlaylistTrackPosition `json:"tracks"` // removed only at the position
+	SnapshotID string                  `json:"snapshotID"`
+}
+
+type ReorderPlaylistTracksRequest struct {
+	RangeStart   int32  `json:"rangeStart"`
+	InsertBefore int32  `json:"insertBefore"`
+	RangeLength  int32  `json:"rangeLength"`
+	SnapshotID   string `json:"snapshotID"`
+}
+
+// handleUpdatePlaylistDetails renames the playlist and changes its description and visibility
+func handleUpdatePlaylistDetails(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	playlistID := r.PathValue("id")
+
+	var requestPayload UpdatePlaylistDetailsRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.UpdatePlaylistDetails(ctx, &proto.UpdatePlaylistDetailsRequest{
+		AccessToken:      accessToken,
+		UserID:           userID,
+		PlaylistID:       playlistID,
+		Name:             requestPayload.Name,
+		Description:      requestPayload.Description,
+		ClearDescription: requestPayload.ClearDescription,
+		Visibility:       requestPayload.Visibility,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleUpdatePlaylistDetails", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleRemovePlaylistTracks removes the tracks by URI or at their positions. The playlist is snapshotted first
+func handleRemovePlaylistTracks(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	playlistID := r.PathValue("id")
+
+	var requestPayload RemovePlaylistTracksRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	if len(requestPayload.URIs) == 0 && len(requestPayload.Tracks) == 0 {
+		http.Error(w, "No tracks to remove", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 30*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	tracks := make([]*proto.PlaylistTrackPosition, 0, len(requestPayload.Tracks))
+	for _, track := range requestPayload.Tracks {
+		tracks = append(tracks, &proto.PlaylistTrackPosition{
+			Uri:      track.URI,
+			Position: track.Position,
+		})
+	}
+
+	response, err := client.RemovePlaylistTracks(ctx, &proto.RemovePlaylistTracksRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		PlaylistID:  playlistID,
+		Uris:        requestPayload.URIs,
+		Tracks:      tracks,
+		SnapshotID:  requestPayload.SnapshotID,
+	})
+
+	if status.Code(err) == codes.FailedPrecondition {
+		// A track is no longer at the given position so nothing was removed
+		slog.Error("Error in handleRemovePlaylistTracks", "error", err)
+		http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+		return
+	} else if err != nil {
+		slog.Error("Error in handleRemovePlaylistTracks", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleReorderPlaylistTracks moves the range of tracks to right before insertBefore
+func handleReorderPlaylistTracks(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	playlistID := r.PathValue("id")
+
+	var requestPayload ReorderPlaylistTracksRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 30*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ReorderPlaylistTracks(ctx, &proto.ReorderPlaylistTracksRequest{
+		AccessToken:  accessToken,
+		UserID:       userID,
+		PlaylistID:   playlistID,
+		RangeStart:   requestPayload.RangeStart,
+		InsertBefore: requestPayload.InsertBefore,
+		RangeLength:  requestPayload.RangeLength,
+		SnapshotID:   requestPayload.SnapshotID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleReorderPlaylistTracks", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleUnfollowPlaylist removes the playlist from the user's library. The user's own playlist is deleted this way
+func handleUnfollowPlaylist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	playlistID := r.PathValue("id")
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.UnfollowPlaylist(ctx, &proto.UnfollowPlaylistRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		PlaylistID:  playlistID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleUnfollowPlaylist", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
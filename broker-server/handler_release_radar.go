@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+)
+
+type EnableReleaseRadarRequest struct {
+	PlaylistID      string `json:"playlistID"`
+	IncludeFollowed bool   `json:"includeFollowed"`
+}
+
+func handleGetReleaseRadar(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.GetReleaseRadar(ctx, &proto.GetReleaseRadarRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleGetReleaseRadar", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleEnableReleaseRadar turns on the weekly release radar. A new playlist is created when playlistID is empty
+func handleEnableReleaseRadar(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload EnableReleaseRadarRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 10*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.EnableReleaseRadar(ctx, &proto.EnableReleaseRadarRequest{
+		AccessToken:     accessToken,
+		UserID:          userID,
+		PlaylistID:      requestPayload.PlaylistID,
+		IncludeFollowed: requestPayload.IncludeFollowed,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleEnableReleaseRadar", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func handleDisableReleaseRadar(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.DisableReleaseRadar(ctx, &proto.DisableReleaseRadarRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleDisableReleaseRadar", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleRunReleaseRadar updates the release radar now instead of waiting for the weekly schedule
+func handleRunReleaseRadar(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.RunReleaseRadar(ctx, &proto.RunReleaseRadarRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleRunReleaseRadar", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+)
+
+type SaveSmartPlaylistRequest struct {
+	Name       string          `json:"name"`
+	PlaylistID string          `json:"playlistID"`
+	Rules      json.RawMessage `json:"rules"`
+}
+
+type EvaluateSmartPlaylistRequest struct {
+	ID     int32  `json:"id"`
+	Date   string `json:"date"`
+	DryRun bool   `json:"dryRun"`
+}
+
+// handleSaveSmartPlaylist saves the rules object of the smart playlist. Saving the same name again replaces the rules
+func handleSaveSmartPlaylist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload SaveSmartPlaylistRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil || requestPayload.Name == "" || len(requestPayload.Rules) == 0 {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.SaveSmartPlaylist(ctx, &proto.SaveSmartPlaylistRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		Name:        requestPayload.Name,
+		PlaylistID:  requestPayload.PlaylistID,
+		Rules:       string(requestPayload.Rules),
+	})
+
+	if err != nil {
+		slog.Error("Error in handleSaveSmartPlaylist", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func handleListSmartPlaylists(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ListSmartPlaylists(ctx, &proto.ListSmartPlaylistsRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleListSmartPlaylists", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func handleDeleteSmartPlaylist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	id, err := strconv.Atoi(r.URL.Query().Get("id"))
+	if err != nil {
+		slog.Error("Invalid id parameter", "error", err)
+		http.Error(w, "Invalid id parameter", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.DeleteSmartPlaylist(ctx, &proto.DeleteSmartPlaylistRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		Id:          int32(id),
+	})
+
+	if err != nil {
+		slog.Error("Error in handleDeleteSmartPlaylist", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleEvaluateSmartPlaylist writes the tracks matching the rules to the playlist (or only returns them with dryRun)
+func handleEvaluateSmartPlaylist(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload EvaluateSmartPlaylistRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil || requestPayload.ID == 0 {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 30*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.EvaluateSmartPlaylist(ctx, &proto.EvaluateSmartPlaylistRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		Id:          requestPayload.ID,
+		Date:        requestPayload.Date,
+		DryRun:      requestPayload.DryRun,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleEvaluateSmartPlaylist", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
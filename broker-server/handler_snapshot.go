@@ -0,0 +1,94 @@
+package main
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+)
+
+// handleListPlaylistSnapshots returns the snapshots taken before melongo wrote to the playlist, newest first
+func handleListPlaylistSnapshots(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	playlistID := r.PathValue("id")
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ListPlaylistSnapshots(ctx, &proto.ListPlaylistSnapshotsRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		PlaylistID:  playlistID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleListPlaylistSnapshots", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleRestorePlaylistSnapshot puts the playlist back to the snapshot
+func handleRestorePlaylistSnapshot(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		slog.Error("Invalid snapshot id", "error", err)
+		http.Error(w, "Invalid snapshot id", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 30*time.Second)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.RestorePlaylistSnapshot(ctx, &proto.RestorePlaylistSnapshotRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		Id:          int32(id),
+	})
+
+	if err != nil {
+		slog.Error("Error in handleRestorePlaylistSnapshot", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
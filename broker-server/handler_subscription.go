@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+)
+
+type CreatePlaylistSubscriptionRequest struct {
+	PlaylistID string `json:"playlistID"`
+	Chart      string `json:"chart"`
+	Policy     string `json:"policy"`
+	WindowDays int32  `json:"windowDays"`
+}
+
+// handleCreatePlaylistSubscription subscribes the playlist to the chart (policy is append_new, mirror or rolling_window)
+func handleCreatePlaylistSubscription(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	var requestPayload CreatePlaylistSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestPayload); err != nil || requestPayload.PlaylistID == "" || requestPayload.Policy == "" {
+		slog.Error("Error decoding payload", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.CreatePlaylistSubscription(ctx, &proto.CreatePlaylistSubscriptionRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		PlaylistID:  requestPayload.PlaylistID,
+		Chart:       requestPayload.Chart,
+		Policy:      requestPayload.Policy,
+		WindowDays:  requestPayload.WindowDays,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleCreatePlaylistSubscription", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func handleListPlaylistSubscriptions(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.ListPlaylistSubscriptions(ctx, &proto.ListPlaylistSubscriptionsRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+	})
+
+	if err != nil {
+		slog.Error("Error in handleListPlaylistSubscriptions", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func handleDeletePlaylistSubscription(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	id, err := strconv.Atoi(r.URL.Query().Get("id"))
+	if err != nil {
+		slog.Error("Invalid id parameter", "error", err)
+		http.Error(w, "Invalid id parameter", http.StatusBadRequest)
+		return
+	}
+
+	conn, client, ctx, cancel, err := connectToGRPCServer("localhost:50002")
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	response, err := client.DeletePlaylistSubscription(ctx, &proto.DeletePlaylistSubscriptionRequest{
+		AccessToken: accessToken,
+		UserID:      userID,
+		Id:          int32(id),
+	})
+
+	if err != nil {
+		slog.Error("Error in handleDeletePlaylistSubscription", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/akimdev15/melongo/broker/proto"
+	"google.golang.org/grpc"
+)
+
+// Largest track list accepted by handleImportTrackList
+const maxTrackListSize = 5 << 20
+
+// handleImportTrackList uploads the track list ("artist - title" per line or CSV with the title and artist columns)
+// as the request body or as the "file" field of a multipart form, and streams its lines to the playlist server.
+// Query params: playlistID (a new playlist is created when empty), name and dryRun
+func handleImportTrackList(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxTrackListSize)
+
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			slog.Error("Error reading the uploaded track list", "error", err)
+			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		slog.Error("Error reading the uploaded track list", "error", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	if len(lines) == 0 {
+		http.Error(w, "No tracks to import", http.StatusBadRequest)
+		return
+	}
+
+	// Every line is searched on spotify
+	conn, client, ctx, cancel, err := connectToGRPCServerWithTimeout("localhost:50002", 5*time.Minute)
+	if err != nil {
+		slog.Error("Error during gRPC connection setup", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer func(conn *grpc.ClientConn) {
+		err := conn.Close()
+		if err != nil {
+			slog.Error("Error closing connection", "error", err)
+		}
+	}(conn)
+
+	defer cancel()
+
+	stream, err := client.ImportTrackList(ctx)
+	if err != nil {
+		slog.Error("Error opening the import stream", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	for _, line := range lines {
+		err := stream.Send(&proto.ImportTrackListLine{
+			AccessToken:  accessToken,
+			UserID:       userID,
+			PlaylistID:   query.Get("playlistID"),
+			PlaylistName: query.Get("name"),
+			DryRun:       query.Get("dryRun") == "true",
+			Line:         line,
+		})
+		if err != nil {
+			slog.Error("Error sending the track list line", "error", err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		slog.Error("Error in handleImportTrackList", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = writeJSON(w, http.StatusOK, response)
+	if err != nil {
+		slog.Error("Error writing JSON", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
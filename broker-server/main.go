@@ -35,12 +35,52 @@ func main() {
 	mux.HandleFunc("GET /authorize", handleAuthorization)
 	mux.HandleFunc("GET /callback", handleSpotifyCallback)
 	mux.HandleFunc("GET /missedTracks", middlewareAuth(handleGetMissedTracks))
-	mux.HandleFunc("GET /playlist/tracks", middlewareAuth(handleGetPlaylistTracks))
+	mux.HandleFunc("GET /missedTracks/candidates", middlewareAuth(handleSuggestCandidates))
 	mux.HandleFunc("GET /playlists", middlewareAuth(handleGetPlaylists))
+	mux.HandleFunc("GET /playlists/{id}", middlewareAuth(handleGetPlaylist))
+	mux.HandleFunc("PUT /playlists/{id}", middlewareAuth(handleUpdatePlaylistDetails))
+	mux.HandleFunc("DELETE /playlists/{id}", middlewareAuth(handleUnfollowPlaylist))
+	mux.HandleFunc("DELETE /playlists/{id}/tracks", middlewareAuth(handleRemovePlaylistTracks))
+	mux.HandleFunc("POST /playlists/{id}/reorder", middlewareAuth(handleReorderPlaylistTracks))
+	mux.HandleFunc("POST /playlists/merge", middlewareAuth(handleMergePlaylists))
+	mux.HandleFunc("POST /playlists/{id}/dedupe", middlewareAuth(handleDedupePlaylist))
+	mux.HandleFunc("POST /playlists/import", middlewareAuth(handleImportTrackList))
+	mux.HandleFunc("GET /playlists/{id}/export", middlewareAuth(handleExportPlaylist))
+	mux.HandleFunc("GET /playlists/{id}/snapshots", middlewareAuth(handleListPlaylistSnapshots))
+	mux.HandleFunc("POST /snapshots/{id}/restore", middlewareAuth(handleRestorePlaylistSnapshot))
+	mux.HandleFunc("POST /operations/undo", middlewareAuth(handleUndoOperation))
 	mux.HandleFunc("POST /createPlaylist", middlewareAuth(handleCreatePlaylist))
 	mux.HandleFunc("POST /melonTop100/create", middlewareAuth(handleMelonTop100))
+	mux.HandleFunc("GET /melonTop100/status", middlewareAuth(handleGetMelonTop100Job))
 	mux.HandleFunc("POST /melonTop100/save", middlewareAuth(handleSaveMelonTop100DB))
 	mux.HandleFunc("POST /resolveMissedTracks", middlewareAuth(handleResolveMissedTracks))
+	mux.HandleFunc("GET /resolveMissedTracks/status", middlewareAuth(handleGetResolveJob))
+	mux.HandleFunc("POST /tracks/report", middlewareAuth(handleReportWrongMatch))
+	mux.HandleFunc("GET /pendingMatches", middlewareAuth(handleListPendingMatches))
+	mux.HandleFunc("POST /pendingMatches/review", middlewareAdmin(handleReviewPendingMatch))
+	mux.HandleFunc("GET /subscriptions", middlewareAuth(handleListPlaylistSubscriptions))
+	mux.HandleFunc("POST /subscriptions", middlewareAuth(handleCreatePlaylistSubscription))
+	mux.HandleFunc("DELETE /subscriptions", middlewareAuth(handleDeletePlaylistSubscription))
+	mux.HandleFunc("GET /favoriteArtists", middlewareAuth(handleListFavoriteArtists))
+	mux.HandleFunc("POST /favoriteArtists", middlewareAuth(handleAddFavoriteArtist))
+	mux.HandleFunc("DELETE /favoriteArtists", middlewareAuth(handleRemoveFavoriteArtist))
+	mux.HandleFunc("POST /favoriteArtists/import", middlewareAuth(handleImportFavoriteArtists))
+	mux.HandleFunc("POST /favoriteArtists/playlist", middlewareAuth(handleFavoriteArtistsPlaylist))
+	mux.HandleFunc("GET /smartPlaylists", middlewareAuth(handleListSmartPlaylists))
+	mux.HandleFunc("POST /smartPlaylists", middlewareAuth(handleSaveSmartPlaylist))
+	mux.HandleFunc("DELETE /smartPlaylists", middlewareAuth(handleDeleteSmartPlaylist))
+	mux.HandleFunc("POST /smartPlaylists/evaluate", middlewareAuth(handleEvaluateSmartPlaylist))
+	mux.HandleFunc("GET /releaseRadar", middlewareAuth(handleGetReleaseRadar))
+	mux.HandleFunc("POST /releaseRadar", middlewareAuth(handleEnableReleaseRadar))
+	mux.HandleFunc("DELETE /releaseRadar", middlewareAuth(handleDisableReleaseRadar))
+	mux.HandleFunc("POST /releaseRadar/run", middlewareAuth(handleRunReleaseRadar))
+
+	// admin routes
+	mux.HandleFunc("GET /admin/resolvedTracks", middlewareAdmin(handleListResolvedTracks))
+	mux.HandleFunc("PUT /admin/resolvedTracks", middlewareAdmin(handleUpdateResolvedTrack))
+	mux.HandleFunc("DELETE /admin/resolvedTracks", middlewareAdmin(handleDeleteResolvedTrack))
+	mux.HandleFunc("POST /admin/resolvedTracks/import", middlewareAdmin(handleImportResolvedTracks))
+	mux.HandleFunc("GET /admin/resolvedTracks/export", middlewareAdmin(handleExportResolvedTracks))
 
 	corsHandler := corsMiddleware(mux)
 
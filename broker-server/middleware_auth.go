@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/akimdev15/melongo/broker/proto"
@@ -68,3 +70,28 @@ func middlewareAuth(handler authHandler) http.HandlerFunc {
 		handler(w, r, accessToken, response.UserID)
 	}
 }
+
+// middlewareAdmin only lets the admin users (comma separated ADMIN_USER_IDS in config.env) call the handler
+func middlewareAdmin(handler authHandler) http.HandlerFunc {
+	return middlewareAuth(func(w http.ResponseWriter, r *http.Request, accessToken string, userID string) {
+		if !isAdminUser(userID) {
+			slog.Error("Non admin user tried to access the admin route", "userID", userID, "path", r.URL.Path)
+			respondWithError(w, 403, "Forbidden. Admin only.")
+			return
+		}
+
+		handler(w, r, accessToken, userID)
+	})
+}
+
+func isAdminUser(userID string) bool {
+	if userID == "" {
+		return false
+	}
+	for _, adminID := range strings.Split(os.Getenv("ADMIN_USER_IDS"), ",") {
+		if strings.TrimSpace(adminID) == userID {
+			return true
+		}
+	}
+	return false
+}
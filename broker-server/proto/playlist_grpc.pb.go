@@ -19,13 +19,52 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PlaylistService_CreatePlaylist_FullMethodName        = "/proto.PlaylistService/CreatePlaylist"
-	PlaylistService_CreateMelonTop100_FullMethodName     = "/proto.PlaylistService/CreateMelonTop100"
-	PlaylistService_SaveMelonTop100DB_FullMethodName     = "/proto.PlaylistService/SaveMelonTop100DB"
-	PlaylistService_GetMissedTracks_FullMethodName       = "/proto.PlaylistService/GetMissedTracks"
-	PlaylistService_ResolveMissedTracks_FullMethodName   = "/proto.PlaylistService/ResolveMissedTracks"
-	PlaylistService_GetUserPlaylists_FullMethodName      = "/proto.PlaylistService/GetUserPlaylists"
-	PlaylistService_GetUserPlaylistTracks_FullMethodName = "/proto.PlaylistService/GetUserPlaylistTracks"
+	PlaylistService_CreatePlaylist_FullMethodName                = "/proto.PlaylistService/CreatePlaylist"
+	PlaylistService_CreateMelonTop100_FullMethodName             = "/proto.PlaylistService/CreateMelonTop100"
+	PlaylistService_GetCreateMelonTop100Job_FullMethodName       = "/proto.PlaylistService/GetCreateMelonTop100Job"
+	PlaylistService_SaveMelonTop100DB_FullMethodName             = "/proto.PlaylistService/SaveMelonTop100DB"
+	PlaylistService_GetMissedTracks_FullMethodName               = "/proto.PlaylistService/GetMissedTracks"
+	PlaylistService_SuggestCandidates_FullMethodName             = "/proto.PlaylistService/SuggestCandidates"
+	PlaylistService_ResolveMissedTracks_FullMethodName           = "/proto.PlaylistService/ResolveMissedTracks"
+	PlaylistService_GetResolveJob_FullMethodName                 = "/proto.PlaylistService/GetResolveJob"
+	PlaylistService_ListResolvedTracks_FullMethodName            = "/proto.PlaylistService/ListResolvedTracks"
+	PlaylistService_UpdateResolvedTrack_FullMethodName           = "/proto.PlaylistService/UpdateResolvedTrack"
+	PlaylistService_DeleteResolvedTrack_FullMethodName           = "/proto.PlaylistService/DeleteResolvedTrack"
+	PlaylistService_ReportWrongMatch_FullMethodName              = "/proto.PlaylistService/ReportWrongMatch"
+	PlaylistService_ImportResolvedTracks_FullMethodName          = "/proto.PlaylistService/ImportResolvedTracks"
+	PlaylistService_ExportResolvedTracks_FullMethodName          = "/proto.PlaylistService/ExportResolvedTracks"
+	PlaylistService_ListPendingMatches_FullMethodName            = "/proto.PlaylistService/ListPendingMatches"
+	PlaylistService_ReviewPendingMatch_FullMethodName            = "/proto.PlaylistService/ReviewPendingMatch"
+	PlaylistService_CreatePlaylistSubscription_FullMethodName    = "/proto.PlaylistService/CreatePlaylistSubscription"
+	PlaylistService_ListPlaylistSubscriptions_FullMethodName     = "/proto.PlaylistService/ListPlaylistSubscriptions"
+	PlaylistService_DeletePlaylistSubscription_FullMethodName    = "/proto.PlaylistService/DeletePlaylistSubscription"
+	PlaylistService_AddFavoriteArtist_FullMethodName             = "/proto.PlaylistService/AddFavoriteArtist"
+	PlaylistService_ListFavoriteArtists_FullMethodName           = "/proto.PlaylistService/ListFavoriteArtists"
+	PlaylistService_RemoveFavoriteArtist_FullMethodName          = "/proto.PlaylistService/RemoveFavoriteArtist"
+	PlaylistService_ImportFavoriteArtists_FullMethodName         = "/proto.PlaylistService/ImportFavoriteArtists"
+	PlaylistService_CreateFavoriteArtistsPlaylist_FullMethodName = "/proto.PlaylistService/CreateFavoriteArtistsPlaylist"
+	PlaylistService_EnableReleaseRadar_FullMethodName            = "/proto.PlaylistService/EnableReleaseRadar"
+	PlaylistService_GetReleaseRadar_FullMethodName               = "/proto.PlaylistService/GetReleaseRadar"
+	PlaylistService_DisableReleaseRadar_FullMethodName           = "/proto.PlaylistService/DisableReleaseRadar"
+	PlaylistService_RunReleaseRadar_FullMethodName               = "/proto.PlaylistService/RunReleaseRadar"
+	PlaylistService_SaveSmartPlaylist_FullMethodName             = "/proto.PlaylistService/SaveSmartPlaylist"
+	PlaylistService_ListSmartPlaylists_FullMethodName            = "/proto.PlaylistService/ListSmartPlaylists"
+	PlaylistService_DeleteSmartPlaylist_FullMethodName           = "/proto.PlaylistService/DeleteSmartPlaylist"
+	PlaylistService_EvaluateSmartPlaylist_FullMethodName         = "/proto.PlaylistService/EvaluateSmartPlaylist"
+	PlaylistService_ListPlaylistSnapshots_FullMethodName         = "/proto.PlaylistService/ListPlaylistSnapshots"
+	PlaylistService_RestorePlaylistSnapshot_FullMethodName       = "/proto.PlaylistService/RestorePlaylistSnapshot"
+	PlaylistService_UndoOperation_FullMethodName                 = "/proto.PlaylistService/UndoOperation"
+	PlaylistService_ExportPlaylist_FullMethodName                = "/proto.PlaylistService/ExportPlaylist"
+	PlaylistService_ImportTrackList_FullMethodName               = "/proto.PlaylistService/ImportTrackList"
+	PlaylistService_MergePlaylists_FullMethodName                = "/proto.PlaylistService/MergePlaylists"
+	PlaylistService_DedupePlaylist_FullMethodName                = "/proto.PlaylistService/DedupePlaylist"
+	PlaylistService_GetPlaylist_FullMethodName                   = "/proto.PlaylistService/GetPlaylist"
+	PlaylistService_UpdatePlaylistDetails_FullMethodName         = "/proto.PlaylistService/UpdatePlaylistDetails"
+	PlaylistService_RemovePlaylistTracks_FullMethodName          = "/proto.PlaylistService/RemovePlaylistTracks"
+	PlaylistService_ReorderPlaylistTracks_FullMethodName         = "/proto.PlaylistService/ReorderPlaylistTracks"
+	PlaylistService_UnfollowPlaylist_FullMethodName              = "/proto.PlaylistService/UnfollowPlaylist"
+	PlaylistService_GetUserPlaylists_FullMethodName              = "/proto.PlaylistService/GetUserPlaylists"
+	PlaylistService_GetUserPlaylistTracks_FullMethodName         = "/proto.PlaylistService/GetUserPlaylistTracks"
 )
 
 // PlaylistServiceClient is the client API for PlaylistService service.
@@ -34,9 +73,48 @@ const (
 type PlaylistServiceClient interface {
 	CreatePlaylist(ctx context.Context, in *CreatePlaylistRequest, opts ...grpc.CallOption) (*CreatePlaylistResponse, error)
 	CreateMelonTop100(ctx context.Context, in *CreateMelonTop100Request, opts ...grpc.CallOption) (*CreateMelonTop100Response, error)
+	GetCreateMelonTop100Job(ctx context.Context, in *GetCreateMelonTop100JobRequest, opts ...grpc.CallOption) (*GetCreateMelonTop100JobResponse, error)
 	SaveMelonTop100DB(ctx context.Context, in *SaveMelonTop100DBRequest, opts ...grpc.CallOption) (*SaveMelonTop100DBResponse, error)
 	GetMissedTracks(ctx context.Context, in *GetMissedTracksRequest, opts ...grpc.CallOption) (*GetMissedTrackResponse, error)
+	SuggestCandidates(ctx context.Context, in *SuggestCandidatesRequest, opts ...grpc.CallOption) (*SuggestCandidatesResponse, error)
 	ResolveMissedTracks(ctx context.Context, in *ResolveMissedTracksRequest, opts ...grpc.CallOption) (*ResolveMissedTracksResponse, error)
+	GetResolveJob(ctx context.Context, in *GetResolveJobRequest, opts ...grpc.CallOption) (*GetResolveJobResponse, error)
+	ListResolvedTracks(ctx context.Context, in *ListResolvedTracksRequest, opts ...grpc.CallOption) (*ListResolvedTracksResponse, error)
+	UpdateResolvedTrack(ctx context.Context, in *UpdateResolvedTrackRequest, opts ...grpc.CallOption) (*UpdateResolvedTrackResponse, error)
+	DeleteResolvedTrack(ctx context.Context, in *DeleteResolvedTrackRequest, opts ...grpc.CallOption) (*DeleteResolvedTrackResponse, error)
+	ReportWrongMatch(ctx context.Context, in *ReportWrongMatchRequest, opts ...grpc.CallOption) (*ReportWrongMatchResponse, error)
+	ImportResolvedTracks(ctx context.Context, opts ...grpc.CallOption) (PlaylistService_ImportResolvedTracksClient, error)
+	ExportResolvedTracks(ctx context.Context, in *ExportResolvedTracksRequest, opts ...grpc.CallOption) (*ExportResolvedTracksResponse, error)
+	ListPendingMatches(ctx context.Context, in *ListPendingMatchesRequest, opts ...grpc.CallOption) (*ListPendingMatchesResponse, error)
+	ReviewPendingMatch(ctx context.Context, in *ReviewPendingMatchRequest, opts ...grpc.CallOption) (*ReviewPendingMatchResponse, error)
+	CreatePlaylistSubscription(ctx context.Context, in *CreatePlaylistSubscriptionRequest, opts ...grpc.CallOption) (*CreatePlaylistSubscriptionResponse, error)
+	ListPlaylistSubscriptions(ctx context.Context, in *ListPlaylistSubscriptionsRequest, opts ...grpc.CallOption) (*ListPlaylistSubscriptionsResponse, error)
+	DeletePlaylistSubscription(ctx context.Context, in *DeletePlaylistSubscriptionRequest, opts ...grpc.CallOption) (*DeletePlaylistSubscriptionResponse, error)
+	AddFavoriteArtist(ctx context.Context, in *AddFavoriteArtistRequest, opts ...grpc.CallOption) (*AddFavoriteArtistResponse, error)
+	ListFavoriteArtists(ctx context.Context, in *ListFavoriteArtistsRequest, opts ...grpc.CallOption) (*ListFavoriteArtistsResponse, error)
+	RemoveFavoriteArtist(ctx context.Context, in *RemoveFavoriteArtistRequest, opts ...grpc.CallOption) (*RemoveFavoriteArtistResponse, error)
+	ImportFavoriteArtists(ctx context.Context, in *ImportFavoriteArtistsRequest, opts ...grpc.CallOption) (*ImportFavoriteArtistsResponse, error)
+	CreateFavoriteArtistsPlaylist(ctx context.Context, in *CreateFavoriteArtistsPlaylistRequest, opts ...grpc.CallOption) (*CreateFavoriteArtistsPlaylistResponse, error)
+	EnableReleaseRadar(ctx context.Context, in *EnableReleaseRadarRequest, opts ...grpc.CallOption) (*EnableReleaseRadarResponse, error)
+	GetReleaseRadar(ctx context.Context, in *GetReleaseRadarRequest, opts ...grpc.CallOption) (*GetReleaseRadarResponse, error)
+	DisableReleaseRadar(ctx context.Context, in *DisableReleaseRadarRequest, opts ...grpc.CallOption) (*DisableReleaseRadarResponse, error)
+	RunReleaseRadar(ctx context.Context, in *RunReleaseRadarRequest, opts ...grpc.CallOption) (*RunReleaseRadarResponse, error)
+	SaveSmartPlaylist(ctx context.Context, in *SaveSmartPlaylistRequest, opts ...grpc.CallOption) (*SaveSmartPlaylistResponse, error)
+	ListSmartPlaylists(ctx context.Context, in *ListSmartPlaylistsRequest, opts ...grpc.CallOption) (*ListSmartPlaylistsResponse, error)
+	DeleteSmartPlaylist(ctx context.Context, in *DeleteSmartPlaylistRequest, opts ...grpc.CallOption) (*DeleteSmartPlaylistResponse, error)
+	EvaluateSmartPlaylist(ctx context.Context, in *EvaluateSmartPlaylistRequest, opts ...grpc.CallOption) (*EvaluateSmartPlaylistResponse, error)
+	ListPlaylistSnapshots(ctx context.Context, in *ListPlaylistSnapshotsRequest, opts ...grpc.CallOption) (*ListPlaylistSnapshotsResponse, error)
+	RestorePlaylistSnapshot(ctx context.Context, in *RestorePlaylistSnapshotRequest, opts ...grpc.CallOption) (*RestorePlaylistSnapshotResponse, error)
+	UndoOperation(ctx context.Context, in *UndoOperationRequest, opts ...grpc.CallOption) (*UndoOperationResponse, error)
+	ExportPlaylist(ctx context.Context, in *ExportPlaylistRequest, opts ...grpc.CallOption) (PlaylistService_ExportPlaylistClient, error)
+	ImportTrackList(ctx context.Context, opts ...grpc.CallOption) (PlaylistService_ImportTrackListClient, error)
+	MergePlaylists(ctx context.Context, in *MergePlaylistsRequest, opts ...grpc.CallOption) (*MergePlaylistsResponse, error)
+	DedupePlaylist(ctx context.Context, in *DedupePlaylistRequest, opts ...grpc.CallOption) (*DedupePlaylistResponse, error)
+	GetPlaylist(ctx context.Context, in *GetPlaylistRequest, opts ...grpc.CallOption) (*GetPlaylistResponse, error)
+	UpdatePlaylistDetails(ctx context.Context, in *UpdatePlaylistDetailsRequest, opts ...grpc.CallOption) (*UpdatePlaylistDetailsResponse, error)
+	RemovePlaylistTracks(ctx context.Context, in *RemovePlaylistTracksRequest, opts ...grpc.CallOption) (*RemovePlaylistTracksResponse, error)
+	ReorderPlaylistTracks(ctx context.Context, in *ReorderPlaylistTracksRequest, opts ...grpc.CallOption) (*ReorderPlaylistTracksResponse, error)
+	UnfollowPlaylist(ctx context.Context, in *UnfollowPlaylistRequest, opts ...grpc.CallOption) (*UnfollowPlaylistResponse, error)
 	GetUserPlaylists(ctx context.Context, in *GetUserPlaylistsRequest, opts ...grpc.CallOption) (*GetUserPlaylistsResponse, error)
 	GetUserPlaylistTracks(ctx context.Context, in *GetUserPlaylistTracksRequest, opts ...grpc.CallOption) (*GetUserPlaylistTracksResponse, error)
 }
@@ -67,189 +145,1490 @@ func (c *playlistServiceClient) CreateMelonTop100(ctx context.Context, in *Creat
 	return out, nil
 }
 
+func (c *playlistServiceClient) GetCreateMelonTop100Job(ctx context.Context, in *GetCreateMelonTop100JobRequest, opts ...grpc.CallOption) (*GetCreateMelonTop100JobResponse, error) {
+	out := new(GetCreateMelonTop100JobResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_GetCreateMelonTop100Job_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playlistServiceClient) SaveMelonTop100DB(ctx context.Context, in *SaveMelonTop100DBRequest, opts ...grpc.CallOption) (*SaveMelonTop100DBResponse, error) {
 	out := new(SaveMelonTop100DBResponse)
 	err := c.cc.Invoke(ctx, PlaylistService_SaveMelonTop100DB_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *playlistServiceClient) GetMissedTracks(ctx context.Context, in *GetMissedTracksRequest, opts ...grpc.CallOption) (*GetMissedTrackResponse, error) {
+	out := new(GetMissedTrackResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_GetMissedTracks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) SuggestCandidates(ctx context.Context, in *SuggestCandidatesRequest, opts ...grpc.CallOption) (*SuggestCandidatesResponse, error) {
+	out := new(SuggestCandidatesResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_SuggestCandidates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ResolveMissedTracks(ctx context.Context, in *ResolveMissedTracksRequest, opts ...grpc.CallOption) (*ResolveMissedTracksResponse, error) {
+	out := new(ResolveMissedTracksResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ResolveMissedTracks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) GetResolveJob(ctx context.Context, in *GetResolveJobRequest, opts ...grpc.CallOption) (*GetResolveJobResponse, error) {
+	out := new(GetResolveJobResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_GetResolveJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListResolvedTracks(ctx context.Context, in *ListResolvedTracksRequest, opts ...grpc.CallOption) (*ListResolvedTracksResponse, error) {
+	out := new(ListResolvedTracksResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListResolvedTracks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) UpdateResolvedTrack(ctx context.Context, in *UpdateResolvedTrackRequest, opts ...grpc.CallOption) (*UpdateResolvedTrackResponse, error) {
+	out := new(UpdateResolvedTrackResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_UpdateResolvedTrack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) DeleteResolvedTrack(ctx context.Context, in *DeleteResolvedTrackRequest, opts ...grpc.CallOption) (*DeleteResolvedTrackResponse, error) {
+	out := new(DeleteResolvedTrackResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_DeleteResolvedTrack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ReportWrongMatch(ctx context.Context, in *ReportWrongMatchRequest, opts ...grpc.CallOption) (*ReportWrongMatchResponse, error) {
+	out := new(ReportWrongMatchResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ReportWrongMatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ImportResolvedTracks(ctx context.Context, opts ...grpc.CallOption) (PlaylistService_ImportResolvedTracksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaylistService_ServiceDesc.Streams[0], PlaylistService_ImportResolvedTracks_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playlistServiceImportResolvedTracksClient{stream}
+	return x, nil
+}
+
+type PlaylistService_ImportResolvedTracksClient interface {
+	Send(*ImportResolvedTrackRow) error
+	CloseAndRecv() (*ImportResolvedTracksResponse, error)
+	grpc.ClientStream
+}
+
+type playlistServiceImportResolvedTracksClient struct {
+	grpc.ClientStream
+}
+
+func (x *playlistServiceImportResolvedTracksClient) Send(m *ImportResolvedTrackRow) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *playlistServiceImportResolvedTracksClient) CloseAndRecv() (*ImportResolvedTracksResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportResolvedTracksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *playlistServiceClient) ExportResolvedTracks(ctx context.Context, in *ExportResolvedTracksRequest, opts ...grpc.CallOption) (*ExportResolvedTracksResponse, error) {
+	out := new(ExportResolvedTracksResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ExportResolvedTracks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListPendingMatches(ctx context.Context, in *ListPendingMatchesRequest, opts ...grpc.CallOption) (*ListPendingMatchesResponse, error) {
+	out := new(ListPendingMatchesResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListPendingMatches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ReviewPendingMatch(ctx context.Context, in *ReviewPendingMatchRequest, opts ...grpc.CallOption) (*ReviewPendingMatchResponse, error) {
+	out := new(ReviewPendingMatchResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ReviewPendingMatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) CreatePlaylistSubscription(ctx context.Context, in *CreatePlaylistSubscriptionRequest, opts ...grpc.CallOption) (*CreatePlaylistSubscriptionResponse, error) {
+	out := new(CreatePlaylistSubscriptionResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_CreatePlaylistSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListPlaylistSubscriptions(ctx context.Context, in *ListPlaylistSubscriptionsRequest, opts ...grpc.CallOption) (*ListPlaylistSubscriptionsResponse, error) {
+	out := new(ListPlaylistSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListPlaylistSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) DeletePlaylistSubscription(ctx context.Context, in *DeletePlaylistSubscriptionRequest, opts ...grpc.CallOption) (*DeletePlaylistSubscriptionResponse, error) {
+	out := new(DeletePlaylistSubscriptionResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_DeletePlaylistSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) AddFavoriteArtist(ctx context.Context, in *AddFavoriteArtistRequest, opts ...grpc.CallOption) (*AddFavoriteArtistResponse, error) {
+	out := new(AddFavoriteArtistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_AddFavoriteArtist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListFavoriteArtists(ctx context.Context, in *ListFavoriteArtistsRequest, opts ...grpc.CallOption) (*ListFavoriteArtistsResponse, error) {
+	out := new(ListFavoriteArtistsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListFavoriteArtists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) RemoveFavoriteArtist(ctx context.Context, in *RemoveFavoriteArtistRequest, opts ...grpc.CallOption) (*RemoveFavoriteArtistResponse, error) {
+	out := new(RemoveFavoriteArtistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_RemoveFavoriteArtist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ImportFavoriteArtists(ctx context.Context, in *ImportFavoriteArtistsRequest, opts ...grpc.CallOption) (*ImportFavoriteArtistsResponse, error) {
+	out := new(ImportFavoriteArtistsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ImportFavoriteArtists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) CreateFavoriteArtistsPlaylist(ctx context.Context, in *CreateFavoriteArtistsPlaylistRequest, opts ...grpc.CallOption) (*CreateFavoriteArtistsPlaylistResponse, error) {
+	out := new(CreateFavoriteArtistsPlaylistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_CreateFavoriteArtistsPlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) EnableReleaseRadar(ctx context.Context, in *EnableReleaseRadarRequest, opts ...grpc.CallOption) (*EnableReleaseRadarResponse, error) {
+	out := new(EnableReleaseRadarResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_EnableReleaseRadar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) GetReleaseRadar(ctx context.Context, in *GetReleaseRadarRequest, opts ...grpc.CallOption) (*GetReleaseRadarResponse, error) {
+	out := new(GetReleaseRadarResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_GetReleaseRadar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) DisableReleaseRadar(ctx context.Context, in *DisableReleaseRadarRequest, opts ...grpc.CallOption) (*DisableReleaseRadarResponse, error) {
+	out := new(DisableReleaseRadarResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_DisableReleaseRadar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) RunReleaseRadar(ctx context.Context, in *RunReleaseRadarRequest, opts ...grpc.CallOption) (*RunReleaseRadarResponse, error) {
+	out := new(RunReleaseRadarResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_RunReleaseRadar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) SaveSmartPlaylist(ctx context.Context, in *SaveSmartPlaylistRequest, opts ...grpc.CallOption) (*SaveSmartPlaylistResponse, error) {
+	out := new(SaveSmartPlaylistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_SaveSmartPlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListSmartPlaylists(ctx context.Context, in *ListSmartPlaylistsRequest, opts ...grpc.CallOption) (*ListSmartPlaylistsResponse, error) {
+	out := new(ListSmartPlaylistsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListSmartPlaylists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) DeleteSmartPlaylist(ctx context.Context, in *DeleteSmartPlaylistRequest, opts ...grpc.CallOption) (*DeleteSmartPlaylistResponse, error) {
+	out := new(DeleteSmartPlaylistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_DeleteSmartPlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) EvaluateSmartPlaylist(ctx context.Context, in *EvaluateSmartPlaylistRequest, opts ...grpc.CallOption) (*EvaluateSmartPlaylistResponse, error) {
+	out := new(EvaluateSmartPlaylistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_EvaluateSmartPlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListPlaylistSnapshots(ctx context.Context, in *ListPlaylistSnapshotsRequest, opts ...grpc.CallOption) (*ListPlaylistSnapshotsResponse, error) {
+	out := new(ListPlaylistSnapshotsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListPlaylistSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) RestorePlaylistSnapshot(ctx context.Context, in *RestorePlaylistSnapshotRequest, opts ...grpc.CallOption) (*RestorePlaylistSnapshotResponse, error) {
+	out := new(RestorePlaylistSnapshotResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_RestorePlaylistSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) UndoOperation(ctx context.Context, in *UndoOperationRequest, opts ...grpc.CallOption) (*UndoOperationResponse, error) {
+	out := new(UndoOperationResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_UndoOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ExportPlaylist(ctx context.Context, in *ExportPlaylistRequest, opts ...grpc.CallOption) (PlaylistService_ExportPlaylistClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaylistService_ServiceDesc.Streams[1], PlaylistService_ExportPlaylist_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playlistServiceExportPlaylistClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlaylistService_ExportPlaylistClient interface {
+	Recv() (*ExportPlaylistChunk, error)
+	grpc.ClientStream
+}
+
+type playlistServiceExportPlaylistClient struct {
+	grpc.ClientStream
+}
+
+func (x *playlistServiceExportPlaylistClient) Recv() (*ExportPlaylistChunk, error) {
+	m := new(ExportPlaylistChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *playlistServiceClient) ImportTrackList(ctx context.Context, opts ...grpc.CallOption) (PlaylistService_ImportTrackListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaylistService_ServiceDesc.Streams[2], PlaylistService_ImportTrackList_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playlistServiceImportTrackListClient{stream}
+	return x, nil
+}
+
+type PlaylistService_ImportTrackListClient interface {
+	Send(*ImportTrackListLine) error
+	CloseAndRecv() (*ImportTrackListResponse, error)
+	grpc.ClientStream
+}
+
+type playlistServiceImportTrackListClient struct {
+	grpc.ClientStream
+}
+
+func (x *playlistServiceImportTrackListClient) Send(m *ImportTrackListLine) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *playlistServiceImportTrackListClient) CloseAndRecv() (*ImportTrackListResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportTrackListResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *playlistServiceClient) MergePlaylists(ctx context.Context, in *MergePlaylistsRequest, opts ...grpc.CallOption) (*MergePlaylistsResponse, error) {
+	out := new(MergePlaylistsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_MergePlaylists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) DedupePlaylist(ctx context.Context, in *DedupePlaylistRequest, opts ...grpc.CallOption) (*DedupePlaylistResponse, error) {
+	out := new(DedupePlaylistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_DedupePlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) GetPlaylist(ctx context.Context, in *GetPlaylistRequest, opts ...grpc.CallOption) (*GetPlaylistResponse, error) {
+	out := new(GetPlaylistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_GetPlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) UpdatePlaylistDetails(ctx context.Context, in *UpdatePlaylistDetailsRequest, opts ...grpc.CallOption) (*UpdatePlaylistDetailsResponse, error) {
+	out := new(UpdatePlaylistDetailsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_UpdatePlaylistDetails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) RemovePlaylistTracks(ctx context.Context, in *RemovePlaylistTracksRequest, opts ...grpc.CallOption) (*RemovePlaylistTracksResponse, error) {
+	out := new(RemovePlaylistTracksResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_RemovePlaylistTracks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ReorderPlaylistTracks(ctx context.Context, in *ReorderPlaylistTracksRequest, opts ...grpc.CallOption) (*ReorderPlaylistTracksResponse, error) {
+	out := new(ReorderPlaylistTracksResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ReorderPlaylistTracks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) UnfollowPlaylist(ctx context.Context, in *UnfollowPlaylistRequest, opts ...grpc.CallOption) (*UnfollowPlaylistResponse, error) {
+	out := new(UnfollowPlaylistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_UnfollowPlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) GetUserPlaylists(ctx context.Context, in *GetUserPlaylistsRequest, opts ...grpc.CallOption) (*GetUserPlaylistsResponse, error) {
+	out := new(GetUserPlaylistsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_GetUserPlaylists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) GetUserPlaylistTracks(ctx context.Context, in *GetUserPlaylistTracksRequest, opts ...grpc.CallOption) (*GetUserPlaylistTracksResponse, error) {
+	out := new(GetUserPlaylistTracksResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_GetUserPlaylistTracks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlaylistServiceServer is the server API for PlaylistService service.
+// All implementations must embed UnimplementedPlaylistServiceServer
+// for forward compatibility
+type PlaylistServiceServer interface {
+	CreatePlaylist(context.Context, *CreatePlaylistRequest) (*CreatePlaylistResponse, error)
+	CreateMelonTop100(context.Context, *CreateMelonTop100Request) (*CreateMelonTop100Response, error)
+	GetCreateMelonTop100Job(context.Context, *GetCreateMelonTop100JobRequest) (*GetCreateMelonTop100JobResponse, error)
+	SaveMelonTop100DB(context.Context, *SaveMelonTop100DBRequest) (*SaveMelonTop100DBResponse, error)
+	GetMissedTracks(context.Context, *GetMissedTracksRequest) (*GetMissedTrackResponse, error)
+	SuggestCandidates(context.Context, *SuggestCandidatesRequest) (*SuggestCandidatesResponse, error)
+	ResolveMissedTracks(context.Context, *ResolveMissedTracksRequest) (*ResolveMissedTracksResponse, error)
+	GetResolveJob(context.Context, *GetResolveJobRequest) (*GetResolveJobResponse, error)
+	ListResolvedTracks(context.Context, *ListResolvedTracksRequest) (*ListResolvedTracksResponse, error)
+	UpdateResolvedTrack(context.Context, *UpdateResolvedTrackRequest) (*UpdateResolvedTrackResponse, error)
+	DeleteResolvedTrack(context.Context, *DeleteResolvedTrackRequest) (*DeleteResolvedTrackResponse, error)
+	ReportWrongMatch(context.Context, *ReportWrongMatchRequest) (*ReportWrongMatchResponse, error)
+	ImportResolvedTracks(PlaylistService_ImportResolvedTracksServer) error
+	ExportResolvedTracks(context.Context, *ExportResolvedTracksRequest) (*ExportResolvedTracksResponse, error)
+	ListPendingMatches(context.Context, *ListPendingMatchesRequest) (*ListPendingMatchesResponse, error)
+	ReviewPendingMatch(context.Context, *ReviewPendingMatchRequest) (*ReviewPendingMatchResponse, error)
+	CreatePlaylistSubscription(context.Context, *CreatePlaylistSubscriptionRequest) (*CreatePlaylistSubscriptionResponse, error)
+	ListPlaylistSubscriptions(context.Context, *ListPlaylistSubscriptionsRequest) (*ListPlaylistSubscriptionsResponse, error)
+	DeletePlaylistSubscription(context.Context, *DeletePlaylistSubscriptionRequest) (*DeletePlaylistSubscriptionResponse, error)
+	AddFavoriteArtist(context.Context, *AddFavoriteArtistRequest) (*AddFavoriteArtistResponse, error)
+	ListFavoriteArtists(context.Context, *ListFavoriteArtistsRequest) (*ListFavoriteArtistsResponse, error)
+	RemoveFavoriteArtist(context.Context, *RemoveFavoriteArtistRequest) (*RemoveFavoriteArtistResponse, error)
+	ImportFavoriteArtists(context.Context, *ImportFavoriteArtistsRequest) (*ImportFavoriteArtistsResponse, error)
+	CreateFavoriteArtistsPlaylist(context.Context, *CreateFavoriteArtistsPlaylistRequest) (*CreateFavoriteArtistsPlaylistResponse, error)
+	EnableReleaseRadar(context.Context, *EnableReleaseRadarRequest) (*EnableReleaseRadarResponse, error)
+	GetReleaseRadar(context.Context, *GetReleaseRadarRequest) (*GetReleaseRadarResponse, error)
+	DisableReleaseRadar(context.Context, *DisableReleaseRadarRequest) (*DisableReleaseRadarResponse, error)
+	RunReleaseRadar(context.Context, *RunReleaseRadarRequest) (*RunReleaseRadarResponse, error)
+	SaveSmartPlaylist(context.Context, *SaveSmartPlaylistRequest) (*SaveSmartPlaylistResponse, error)
+	ListSmartPlaylists(context.Context, *ListSmartPlaylistsRequest) (*ListSmartPlaylistsResponse, error)
+	DeleteSmartPlaylist(context.Context, *DeleteSmartPlaylistRequest) (*DeleteSmartPlaylistResponse, error)
+	EvaluateSmartPlaylist(context.Context, *EvaluateSmartPlaylistRequest) (*EvaluateSmartPlaylistResponse, error)
+	ListPlaylistSnapshots(context.Context, *ListPlaylistSnapshotsRequest) (*ListPlaylistSnapshotsResponse, error)
+	RestorePlaylistSnapshot(context.Context, *RestorePlaylistSnapshotRequest) (*RestorePlaylistSnapshotResponse, error)
+	UndoOperation(context.Context, *UndoOperationRequest) (*UndoOperationResponse, error)
+	ExportPlaylist(*ExportPlaylistRequest, PlaylistService_ExportPlaylistServer) error
+	ImportTrackList(PlaylistService_ImportTrackListServer) error
+	MergePlaylists(context.Context, *MergePlaylistsRequest) (*MergePlaylistsResponse, error)
+	DedupePlaylist(context.Context, *DedupePlaylistRequest) (*DedupePlaylistResponse, error)
+	GetPlaylist(context.Context, *GetPlaylistRequest) (*GetPlaylistResponse, error)
+	UpdatePlaylistDetails(context.Context, *UpdatePlaylistDetailsRequest) (*UpdatePlaylistDetailsResponse, error)
+	RemovePlaylistTracks(context.Context, *RemovePlaylistTracksRequest) (*RemovePlaylistTracksResponse, error)
+	ReorderPlaylistTracks(context.Context, *ReorderPlaylistTracksRequest) (*ReorderPlaylistTracksResponse, error)
+	UnfollowPlaylist(context.Context, *UnfollowPlaylistRequest) (*UnfollowPlaylistResponse, error)
+	GetUserPlaylists(context.Context, *GetUserPlaylistsRequest) (*GetUserPlaylistsResponse, error)
+	GetUserPlaylistTracks(context.Context, *GetUserPlaylistTracksRequest) (*GetUserPlaylistTracksResponse, error)
+	mustEmbedUnimplementedPlaylistServiceServer()
+}
+
+// UnimplementedPlaylistServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPlaylistServiceServer struct {
+}
+
+func (UnimplementedPlaylistServiceServer) CreatePlaylist(context.Context, *CreatePlaylistRequest) (*CreatePlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) CreateMelonTop100(context.Context, *CreateMelonTop100Request) (*CreateMelonTop100Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMelonTop100 not implemented")
+}
+func (UnimplementedPlaylistServiceServer) GetCreateMelonTop100Job(context.Context, *GetCreateMelonTop100JobRequest) (*GetCreateMelonTop100JobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreateMelonTop100Job not implemented")
+}
+func (UnimplementedPlaylistServiceServer) SaveMelonTop100DB(context.Context, *SaveMelonTop100DBRequest) (*SaveMelonTop100DBResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveMelonTop100DB not implemented")
+}
+func (UnimplementedPlaylistServiceServer) GetMissedTracks(context.Context, *GetMissedTracksRequest) (*GetMissedTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMissedTracks not implemented")
+}
+func (UnimplementedPlaylistServiceServer) SuggestCandidates(context.Context, *SuggestCandidatesRequest) (*SuggestCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestCandidates not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ResolveMissedTracks(context.Context, *ResolveMissedTracksRequest) (*ResolveMissedTracksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveMissedTracks not implemented")
+}
+func (UnimplementedPlaylistServiceServer) GetResolveJob(context.Context, *GetResolveJobRequest) (*GetResolveJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResolveJob not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListResolvedTracks(context.Context, *ListResolvedTracksRequest) (*ListResolvedTracksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResolvedTracks not implemented")
+}
+func (UnimplementedPlaylistServiceServer) UpdateResolvedTrack(context.Context, *UpdateResolvedTrackRequest) (*UpdateResolvedTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateResolvedTrack not implemented")
+}
+func (UnimplementedPlaylistServiceServer) DeleteResolvedTrack(context.Context, *DeleteResolvedTrackRequest) (*DeleteResolvedTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResolvedTrack not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ReportWrongMatch(context.Context, *ReportWrongMatchRequest) (*ReportWrongMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportWrongMatch not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ImportResolvedTracks(PlaylistService_ImportResolvedTracksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportResolvedTracks not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ExportResolvedTracks(context.Context, *ExportResolvedTracksRequest) (*ExportResolvedTracksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportResolvedTracks not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListPendingMatches(context.Context, *ListPendingMatchesRequest) (*ListPendingMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingMatches not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ReviewPendingMatch(context.Context, *ReviewPendingMatchRequest) (*ReviewPendingMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewPendingMatch not implemented")
+}
+func (UnimplementedPlaylistServiceServer) CreatePlaylistSubscription(context.Context, *CreatePlaylistSubscriptionRequest) (*CreatePlaylistSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaylistSubscription not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListPlaylistSubscriptions(context.Context, *ListPlaylistSubscriptionsRequest) (*ListPlaylistSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlaylistSubscriptions not implemented")
+}
+func (UnimplementedPlaylistServiceServer) DeletePlaylistSubscription(context.Context, *DeletePlaylistSubscriptionRequest) (*DeletePlaylistSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlaylistSubscription not implemented")
+}
+func (UnimplementedPlaylistServiceServer) AddFavoriteArtist(context.Context, *AddFavoriteArtistRequest) (*AddFavoriteArtistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavoriteArtist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListFavoriteArtists(context.Context, *ListFavoriteArtistsRequest) (*ListFavoriteArtistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavoriteArtists not implemented")
+}
+func (UnimplementedPlaylistServiceServer) RemoveFavoriteArtist(context.Context, *RemoveFavoriteArtistRequest) (*RemoveFavoriteArtistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavoriteArtist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ImportFavoriteArtists(context.Context, *ImportFavoriteArtistsRequest) (*ImportFavoriteArtistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFavoriteArtists not implemented")
+}
+func (UnimplementedPlaylistServiceServer) CreateFavoriteArtistsPlaylist(context.Context, *CreateFavoriteArtistsPlaylistRequest) (*CreateFavoriteArtistsPlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFavoriteArtistsPlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) EnableReleaseRadar(context.Context, *EnableReleaseRadarRequest) (*EnableReleaseRadarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableReleaseRadar not implemented")
+}
+func (UnimplementedPlaylistServiceServer) GetReleaseRadar(context.Context, *GetReleaseRadarRequest) (*GetReleaseRadarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseRadar not implemented")
+}
+func (UnimplementedPlaylistServiceServer) DisableReleaseRadar(context.Context, *DisableReleaseRadarRequest) (*DisableReleaseRadarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableReleaseRadar not implemented")
+}
+func (UnimplementedPlaylistServiceServer) RunReleaseRadar(context.Context, *RunReleaseRadarRequest) (*RunReleaseRadarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunReleaseRadar not implemented")
+}
+func (UnimplementedPlaylistServiceServer) SaveSmartPlaylist(context.Context, *SaveSmartPlaylistRequest) (*SaveSmartPlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSmartPlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListSmartPlaylists(context.Context, *ListSmartPlaylistsRequest) (*ListSmartPlaylistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSmartPlaylists not implemented")
+}
+func (UnimplementedPlaylistServiceServer) DeleteSmartPlaylist(context.Context, *DeleteSmartPlaylistRequest) (*DeleteSmartPlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSmartPlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) EvaluateSmartPlaylist(context.Context, *EvaluateSmartPlaylistRequest) (*EvaluateSmartPlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateSmartPlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListPlaylistSnapshots(context.Context, *ListPlaylistSnapshotsRequest) (*ListPlaylistSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlaylistSnapshots not implemented")
+}
+func (UnimplementedPlaylistServiceServer) RestorePlaylistSnapshot(context.Context, *RestorePlaylistSnapshotRequest) (*RestorePlaylistSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePlaylistSnapshot not implemented")
+}
+func (UnimplementedPlaylistServiceServer) UndoOperation(context.Context, *UndoOperationRequest) (*UndoOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoOperation not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ExportPlaylist(*ExportPlaylistRequest, PlaylistService_ExportPlaylistServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ImportTrackList(PlaylistService_ImportTrackListServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportTrackList not implemented")
+}
+func (UnimplementedPlaylistServiceServer) MergePlaylists(context.Context, *MergePlaylistsRequest) (*MergePlaylistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePlaylists not implemented")
+}
+func (UnimplementedPlaylistServiceServer) DedupePlaylist(context.Context, *DedupePlaylistRequest) (*DedupePlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DedupePlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) GetPlaylist(context.Context, *GetPlaylistRequest) (*GetPlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) UpdatePlaylistDetails(context.Context, *UpdatePlaylistDetailsRequest) (*UpdatePlaylistDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlaylistDetails not implemented")
+}
+func (UnimplementedPlaylistServiceServer) RemovePlaylistTracks(context.Context, *RemovePlaylistTracksRequest) (*RemovePlaylistTracksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlaylistTracks not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ReorderPlaylistTracks(context.Context, *ReorderPlaylistTracksRequest) (*ReorderPlaylistTracksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPlaylistTracks not implemented")
+}
+func (UnimplementedPlaylistServiceServer) UnfollowPlaylist(context.Context, *UnfollowPlaylistRequest) (*UnfollowPlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowPlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) GetUserPlaylists(context.Context, *GetUserPlaylistsRequest) (*GetUserPlaylistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPlaylists not implemented")
+}
+func (UnimplementedPlaylistServiceServer) GetUserPlaylistTracks(context.Context, *GetUserPlaylistTracksRequest) (*GetUserPlaylistTracksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPlaylistTracks not implemented")
+}
+func (UnimplementedPlaylistServiceServer) mustEmbedUnimplementedPlaylistServiceServer() {}
+
+// UnsafePlaylistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlaylistServiceServer will
+// result in compilation errors.
+type UnsafePlaylistServiceServer interface {
+	mustEmbedUnimplementedPlaylistServiceServer()
+}
+
+func RegisterPlaylistServiceServer(s grpc.ServiceRegistrar, srv PlaylistServiceServer) {
+	s.RegisterService(&PlaylistService_ServiceDesc, srv)
+}
+
+func _PlaylistService_CreatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).CreatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_CreatePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).CreatePlaylist(ctx, req.(*CreatePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_CreateMelonTop100_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMelonTop100Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).CreateMelonTop100(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_CreateMelonTop100_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).CreateMelonTop100(ctx, req.(*CreateMelonTop100Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_GetCreateMelonTop100Job_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreateMelonTop100JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).GetCreateMelonTop100Job(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_GetCreateMelonTop100Job_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).GetCreateMelonTop100Job(ctx, req.(*GetCreateMelonTop100JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_SaveMelonTop100DB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveMelonTop100DBRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).SaveMelonTop100DB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_SaveMelonTop100DB_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).SaveMelonTop100DB(ctx, req.(*SaveMelonTop100DBRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_GetMissedTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMissedTracksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).GetMissedTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_GetMissedTracks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).GetMissedTracks(ctx, req.(*GetMissedTracksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_SuggestCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).SuggestCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_SuggestCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).SuggestCandidates(ctx, req.(*SuggestCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ResolveMissedTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveMissedTracksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ResolveMissedTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ResolveMissedTracks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ResolveMissedTracks(ctx, req.(*ResolveMissedTracksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_GetResolveJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResolveJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).GetResolveJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_GetResolveJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).GetResolveJob(ctx, req.(*GetResolveJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListResolvedTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResolvedTracksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListResolvedTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ListResolvedTracks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListResolvedTracks(ctx, req.(*ListResolvedTracksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_UpdateResolvedTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResolvedTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).UpdateResolvedTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_UpdateResolvedTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).UpdateResolvedTrack(ctx, req.(*UpdateResolvedTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_DeleteResolvedTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResolvedTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).DeleteResolvedTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_DeleteResolvedTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).DeleteResolvedTrack(ctx, req.(*DeleteResolvedTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ReportWrongMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportWrongMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ReportWrongMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ReportWrongMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ReportWrongMatch(ctx, req.(*ReportWrongMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ImportResolvedTracks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PlaylistServiceServer).ImportResolvedTracks(&playlistServiceImportResolvedTracksServer{stream})
+}
+
+type PlaylistService_ImportResolvedTracksServer interface {
+	SendAndClose(*ImportResolvedTracksResponse) error
+	Recv() (*ImportResolvedTrackRow, error)
+	grpc.ServerStream
+}
+
+type playlistServiceImportResolvedTracksServer struct {
+	grpc.ServerStream
+}
+
+func (x *playlistServiceImportResolvedTracksServer) SendAndClose(m *ImportResolvedTracksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *playlistServiceImportResolvedTracksServer) Recv() (*ImportResolvedTrackRow, error) {
+	m := new(ImportResolvedTrackRow)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PlaylistService_ExportResolvedTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportResolvedTracksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ExportResolvedTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ExportResolvedTracks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ExportResolvedTracks(ctx, req.(*ExportResolvedTracksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListPendingMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListPendingMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ListPendingMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListPendingMatches(ctx, req.(*ListPendingMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ReviewPendingMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPendingMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ReviewPendingMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ReviewPendingMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ReviewPendingMatch(ctx, req.(*ReviewPendingMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_CreatePlaylistSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaylistSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).CreatePlaylistSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_CreatePlaylistSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).CreatePlaylistSubscription(ctx, req.(*CreatePlaylistSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListPlaylistSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlaylistSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListPlaylistSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ListPlaylistSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListPlaylistSubscriptions(ctx, req.(*ListPlaylistSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_DeletePlaylistSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlaylistSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).DeletePlaylistSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_DeletePlaylistSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).DeletePlaylistSubscription(ctx, req.(*DeletePlaylistSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_AddFavoriteArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).AddFavoriteArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_AddFavoriteArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).AddFavoriteArtist(ctx, req.(*AddFavoriteArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListFavoriteArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoriteArtistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListFavoriteArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ListFavoriteArtists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListFavoriteArtists(ctx, req.(*ListFavoriteArtistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_RemoveFavoriteArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavoriteArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).RemoveFavoriteArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_RemoveFavoriteArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).RemoveFavoriteArtist(ctx, req.(*RemoveFavoriteArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ImportFavoriteArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFavoriteArtistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ImportFavoriteArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ImportFavoriteArtists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ImportFavoriteArtists(ctx, req.(*ImportFavoriteArtistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_CreateFavoriteArtistsPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFavoriteArtistsPlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).CreateFavoriteArtistsPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_CreateFavoriteArtistsPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).CreateFavoriteArtistsPlaylist(ctx, req.(*CreateFavoriteArtistsPlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_EnableReleaseRadar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableReleaseRadarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).EnableReleaseRadar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_EnableReleaseRadar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).EnableReleaseRadar(ctx, req.(*EnableReleaseRadarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_GetReleaseRadar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseRadarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).GetReleaseRadar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_GetReleaseRadar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).GetReleaseRadar(ctx, req.(*GetReleaseRadarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_DisableReleaseRadar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableReleaseRadarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).DisableReleaseRadar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_DisableReleaseRadar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).DisableReleaseRadar(ctx, req.(*DisableReleaseRadarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_RunReleaseRadar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunReleaseRadarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).RunReleaseRadar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_RunReleaseRadar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).RunReleaseRadar(ctx, req.(*RunReleaseRadarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_SaveSmartPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSmartPlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).SaveSmartPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_SaveSmartPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).SaveSmartPlaylist(ctx, req.(*SaveSmartPlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListSmartPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSmartPlaylistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListSmartPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ListSmartPlaylists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListSmartPlaylists(ctx, req.(*ListSmartPlaylistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *playlistServiceClient) GetMissedTracks(ctx context.Context, in *GetMissedTracksRequest, opts ...grpc.CallOption) (*GetMissedTrackResponse, error) {
-	out := new(GetMissedTrackResponse)
-	err := c.cc.Invoke(ctx, PlaylistService_GetMissedTracks_FullMethodName, in, out, opts...)
-	if err != nil {
+func _PlaylistService_DeleteSmartPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSmartPlaylistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).DeleteSmartPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_DeleteSmartPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).DeleteSmartPlaylist(ctx, req.(*DeleteSmartPlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *playlistServiceClient) ResolveMissedTracks(ctx context.Context, in *ResolveMissedTracksRequest, opts ...grpc.CallOption) (*ResolveMissedTracksResponse, error) {
-	out := new(ResolveMissedTracksResponse)
-	err := c.cc.Invoke(ctx, PlaylistService_ResolveMissedTracks_FullMethodName, in, out, opts...)
-	if err != nil {
+func _PlaylistService_EvaluateSmartPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateSmartPlaylistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).EvaluateSmartPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_EvaluateSmartPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).EvaluateSmartPlaylist(ctx, req.(*EvaluateSmartPlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *playlistServiceClient) GetUserPlaylists(ctx context.Context, in *GetUserPlaylistsRequest, opts ...grpc.CallOption) (*GetUserPlaylistsResponse, error) {
-	out := new(GetUserPlaylistsResponse)
-	err := c.cc.Invoke(ctx, PlaylistService_GetUserPlaylists_FullMethodName, in, out, opts...)
-	if err != nil {
+func _PlaylistService_ListPlaylistSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlaylistSnapshotsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListPlaylistSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ListPlaylistSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListPlaylistSnapshots(ctx, req.(*ListPlaylistSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *playlistServiceClient) GetUserPlaylistTracks(ctx context.Context, in *GetUserPlaylistTracksRequest, opts ...grpc.CallOption) (*GetUserPlaylistTracksResponse, error) {
-	out := new(GetUserPlaylistTracksResponse)
-	err := c.cc.Invoke(ctx, PlaylistService_GetUserPlaylistTracks_FullMethodName, in, out, opts...)
-	if err != nil {
+func _PlaylistService_RestorePlaylistSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePlaylistSnapshotRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).RestorePlaylistSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_RestorePlaylistSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).RestorePlaylistSnapshot(ctx, req.(*RestorePlaylistSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// PlaylistServiceServer is the server API for PlaylistService service.
-// All implementations must embed UnimplementedPlaylistServiceServer
-// for forward compatibility
-type PlaylistServiceServer interface {
-	CreatePlaylist(context.Context, *CreatePlaylistRequest) (*CreatePlaylistResponse, error)
-	CreateMelonTop100(context.Context, *CreateMelonTop100Request) (*CreateMelonTop100Response, error)
-	SaveMelonTop100DB(context.Context, *SaveMelonTop100DBRequest) (*SaveMelonTop100DBResponse, error)
-	GetMissedTracks(context.Context, *GetMissedTracksRequest) (*GetMissedTrackResponse, error)
-	ResolveMissedTracks(context.Context, *ResolveMissedTracksRequest) (*ResolveMissedTracksResponse, error)
-	GetUserPlaylists(context.Context, *GetUserPlaylistsRequest) (*GetUserPlaylistsResponse, error)
-	GetUserPlaylistTracks(context.Context, *GetUserPlaylistTracksRequest) (*GetUserPlaylistTracksResponse, error)
-	mustEmbedUnimplementedPlaylistServiceServer()
+func _PlaylistService_UndoOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).UndoOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_UndoOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).UndoOperation(ctx, req.(*UndoOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedPlaylistServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedPlaylistServiceServer struct {
+func _PlaylistService_ExportPlaylist_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPlaylistRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaylistServiceServer).ExportPlaylist(m, &playlistServiceExportPlaylistServer{stream})
 }
 
-func (UnimplementedPlaylistServiceServer) CreatePlaylist(context.Context, *CreatePlaylistRequest) (*CreatePlaylistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaylist not implemented")
+type PlaylistService_ExportPlaylistServer interface {
+	Send(*ExportPlaylistChunk) error
+	grpc.ServerStream
 }
-func (UnimplementedPlaylistServiceServer) CreateMelonTop100(context.Context, *CreateMelonTop100Request) (*CreateMelonTop100Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMelonTop100 not implemented")
+
+type playlistServiceExportPlaylistServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedPlaylistServiceServer) SaveMelonTop100DB(context.Context, *SaveMelonTop100DBRequest) (*SaveMelonTop100DBResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveMelonTop100DB not implemented")
+
+func (x *playlistServiceExportPlaylistServer) Send(m *ExportPlaylistChunk) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedPlaylistServiceServer) GetMissedTracks(context.Context, *GetMissedTracksRequest) (*GetMissedTrackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMissedTracks not implemented")
+
+func _PlaylistService_ImportTrackList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PlaylistServiceServer).ImportTrackList(&playlistServiceImportTrackListServer{stream})
 }
-func (UnimplementedPlaylistServiceServer) ResolveMissedTracks(context.Context, *ResolveMissedTracksRequest) (*ResolveMissedTracksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResolveMissedTracks not implemented")
+
+type PlaylistService_ImportTrackListServer interface {
+	SendAndClose(*ImportTrackListResponse) error
+	Recv() (*ImportTrackListLine, error)
+	grpc.ServerStream
 }
-func (UnimplementedPlaylistServiceServer) GetUserPlaylists(context.Context, *GetUserPlaylistsRequest) (*GetUserPlaylistsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserPlaylists not implemented")
+
+type playlistServiceImportTrackListServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedPlaylistServiceServer) GetUserPlaylistTracks(context.Context, *GetUserPlaylistTracksRequest) (*GetUserPlaylistTracksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserPlaylistTracks not implemented")
+
+func (x *playlistServiceImportTrackListServer) SendAndClose(m *ImportTrackListResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedPlaylistServiceServer) mustEmbedUnimplementedPlaylistServiceServer() {}
 
-// UnsafePlaylistServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PlaylistServiceServer will
-// result in compilation errors.
-type UnsafePlaylistServiceServer interface {
-	mustEmbedUnimplementedPlaylistServiceServer()
+func (x *playlistServiceImportTrackListServer) Recv() (*ImportTrackListLine, error) {
+	m := new(ImportTrackListLine)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func RegisterPlaylistServiceServer(s grpc.ServiceRegistrar, srv PlaylistServiceServer) {
-	s.RegisterService(&PlaylistService_ServiceDesc, srv)
+func _PlaylistService_MergePlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePlaylistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).MergePlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_MergePlaylists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).MergePlaylists(ctx, req.(*MergePlaylistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PlaylistService_CreatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePlaylistRequest)
+func _PlaylistService_DedupePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DedupePlaylistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlaylistServiceServer).CreatePlaylist(ctx, in)
+		return srv.(PlaylistServiceServer).DedupePlaylist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlaylistService_CreatePlaylist_FullMethodName,
+		FullMethod: PlaylistService_DedupePlaylist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlaylistServiceServer).CreatePlaylist(ctx, req.(*CreatePlaylistRequest))
+		return srv.(PlaylistServiceServer).DedupePlaylist(ctx, req.(*DedupePlaylistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PlaylistService_CreateMelonTop100_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMelonTop100Request)
+func _PlaylistService_GetPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaylistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlaylistServiceServer).CreateMelonTop100(ctx, in)
+		return srv.(PlaylistServiceServer).GetPlaylist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlaylistService_CreateMelonTop100_FullMethodName,
+		FullMethod: PlaylistService_GetPlaylist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlaylistServiceServer).CreateMelonTop100(ctx, req.(*CreateMelonTop100Request))
+		return srv.(PlaylistServiceServer).GetPlaylist(ctx, req.(*GetPlaylistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PlaylistService_SaveMelonTop100DB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveMelonTop100DBRequest)
+func _PlaylistService_UpdatePlaylistDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlaylistDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlaylistServiceServer).SaveMelonTop100DB(ctx, in)
+		return srv.(PlaylistServiceServer).UpdatePlaylistDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlaylistService_SaveMelonTop100DB_FullMethodName,
+		FullMethod: PlaylistService_UpdatePlaylistDetails_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlaylistServiceServer).SaveMelonTop100DB(ctx, req.(*SaveMelonTop100DBRequest))
+		return srv.(PlaylistServiceServer).UpdatePlaylistDetails(ctx, req.(*UpdatePlaylistDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PlaylistService_GetMissedTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMissedTracksRequest)
+func _PlaylistService_RemovePlaylistTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePlaylistTracksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlaylistServiceServer).GetMissedTracks(ctx, in)
+		return srv.(PlaylistServiceServer).RemovePlaylistTracks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlaylistService_GetMissedTracks_FullMethodName,
+		FullMethod: PlaylistService_RemovePlaylistTracks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlaylistServiceServer).GetMissedTracks(ctx, req.(*GetMissedTracksRequest))
+		return srv.(PlaylistServiceServer).RemovePlaylistTracks(ctx, req.(*RemovePlaylistTracksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PlaylistService_ResolveMissedTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResolveMissedTracksRequest)
+func _PlaylistService_ReorderPlaylistTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPlaylistTracksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlaylistServiceServer).ResolveMissedTracks(ctx, in)
+		return srv.(PlaylistServiceServer).ReorderPlaylistTracks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlaylistService_ResolveMissedTracks_FullMethodName,
+		FullMethod: PlaylistService_ReorderPlaylistTracks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlaylistServiceServer).ResolveMissedTracks(ctx, req.(*ResolveMissedTracksRequest))
+		return srv.(PlaylistServiceServer).ReorderPlaylistTracks(ctx, req.(*ReorderPlaylistTracksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_UnfollowPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowPlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).UnfollowPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_UnfollowPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).UnfollowPlaylist(ctx, req.(*UnfollowPlaylistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -305,6 +1684,10 @@ var PlaylistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateMelonTop100",
 			Handler:    _PlaylistService_CreateMelonTop100_Handler,
 		},
+		{
+			MethodName: "GetCreateMelonTop100Job",
+			Handler:    _PlaylistService_GetCreateMelonTop100Job_Handler,
+		},
 		{
 			MethodName: "SaveMelonTop100DB",
 			Handler:    _PlaylistService_SaveMelonTop100DB_Handler,
@@ -313,10 +1696,150 @@ var PlaylistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMissedTracks",
 			Handler:    _PlaylistService_GetMissedTracks_Handler,
 		},
+		{
+			MethodName: "SuggestCandidates",
+			Handler:    _PlaylistService_SuggestCandidates_Handler,
+		},
 		{
 			MethodName: "ResolveMissedTracks",
 			Handler:    _PlaylistService_ResolveMissedTracks_Handler,
 		},
+		{
+			MethodName: "GetResolveJob",
+			Handler:    _PlaylistService_GetResolveJob_Handler,
+		},
+		{
+			MethodName: "ListResolvedTracks",
+			Handler:    _PlaylistService_ListResolvedTracks_Handler,
+		},
+		{
+			MethodName: "UpdateResolvedTrack",
+			Handler:    _PlaylistService_UpdateResolvedTrack_Handler,
+		},
+		{
+			MethodName: "DeleteResolvedTrack",
+			Handler:    _PlaylistService_DeleteResolvedTrack_Handler,
+		},
+		{
+			MethodName: "ReportWrongMatch",
+			Handler:    _PlaylistService_ReportWrongMatch_Handler,
+		},
+		{
+			MethodName: "ExportResolvedTracks",
+			Handler:    _PlaylistService_ExportResolvedTracks_Handler,
+		},
+		{
+			MethodName: "ListPendingMatches",
+			Handler:    _PlaylistService_ListPendingMatches_Handler,
+		},
+		{
+			MethodName: "ReviewPendingMatch",
+			Handler:    _PlaylistService_ReviewPendingMatch_Handler,
+		},
+		{
+			MethodName: "CreatePlaylistSubscription",
+			Handler:    _PlaylistService_CreatePlaylistSubscription_Handler,
+		},
+		{
+			MethodName: "ListPlaylistSubscriptions",
+			Handler:    _PlaylistService_ListPlaylistSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeletePlaylistSubscription",
+			Handler:    _PlaylistService_DeletePlaylistSubscription_Handler,
+		},
+		{
+			MethodName: "AddFavoriteArtist",
+			Handler:    _PlaylistService_AddFavoriteArtist_Handler,
+		},
+		{
+			MethodName: "ListFavoriteArtists",
+			Handler:    _PlaylistService_ListFavoriteArtists_Handler,
+		},
+		{
+			MethodName: "RemoveFavoriteArtist",
+			Handler:    _PlaylistService_RemoveFavoriteArtist_Handler,
+		},
+		{
+			MethodName: "ImportFavoriteArtists",
+			Handler:    _PlaylistService_ImportFavoriteArtists_Handler,
+		},
+		{
+			MethodName: "CreateFavoriteArtistsPlaylist",
+			Handler:    _PlaylistService_CreateFavoriteArtistsPlaylist_Handler,
+		},
+		{
+			MethodName: "EnableReleaseRadar",
+			Handler:    _PlaylistService_EnableReleaseRadar_Handler,
+		},
+		{
+			MethodName: "GetReleaseRadar",
+			Handler:    _PlaylistService_GetReleaseRadar_Handler,
+		},
+		{
+			MethodName: "DisableReleaseRadar",
+			Handler:    _PlaylistService_DisableReleaseRadar_Handler,
+		},
+		{
+			MethodName: "RunReleaseRadar",
+			Handler:    _PlaylistService_RunReleaseRadar_Handler,
+		},
+		{
+			MethodName: "SaveSmartPlaylist",
+			Handler:    _PlaylistService_SaveSmartPlaylist_Handler,
+		},
+		{
+			MethodName: "ListSmartPlaylists",
+			Handler:    _PlaylistService_ListSmartPlaylists_Handler,
+		},
+		{
+			MethodName: "DeleteSmartPlaylist",
+			Handler:    _PlaylistService_DeleteSmartPlaylist_Handler,
+		},
+		{
+			MethodName: "EvaluateSmartPlaylist",
+			Handler:    _PlaylistService_EvaluateSmartPlaylist_Handler,
+		},
+		{
+			MethodName: "ListPlaylistSnapshots",
+			Handler:    _PlaylistService_ListPlaylistSnapshots_Handler,
+		},
+		{
+			MethodName: "RestorePlaylistSnapshot",
+			Handler:    _PlaylistService_RestorePlaylistSnapshot_Handler,
+		},
+		{
+			MethodName: "UndoOperation",
+			Handler:    _PlaylistService_UndoOperation_Handler,
+		},
+		{
+			MethodName: "MergePlaylists",
+			Handler:    _PlaylistService_MergePlaylists_Handler,
+		},
+		{
+			MethodName: "DedupePlaylist",
+			Handler:    _PlaylistService_DedupePlaylist_Handler,
+		},
+		{
+			MethodName: "GetPlaylist",
+			Handler:    _PlaylistService_GetPlaylist_Handler,
+		},
+		{
+			MethodName: "UpdatePlaylistDetails",
+			Handler:    _PlaylistService_UpdatePlaylistDetails_Handler,
+		},
+		{
+			MethodName: "RemovePlaylistTracks",
+			Handler:    _PlaylistService_RemovePlaylistTracks_Handler,
+		},
+		{
+			MethodName: "ReorderPlaylistTracks",
+			Handler:    _PlaylistService_ReorderPlaylistTracks_Handler,
+		},
+		{
+			MethodName: "UnfollowPlaylist",
+			Handler:    _PlaylistService_UnfollowPlaylist_Handler,
+		},
 		{
 			MethodName: "GetUserPlaylists",
 			Handler:    _PlaylistService_GetUserPlaylists_Handler,
@@ -326,6 +1849,22 @@ var PlaylistService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PlaylistService_GetUserPlaylistTracks_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportResolvedTracks",
+			Handler:       _PlaylistService_ImportResolvedTracks_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportPlaylist",
+			Handler:       _PlaylistService_ExportPlaylist_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportTrackList",
+			Handler:       _PlaylistService_ImportTrackList_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "playlist.proto",
 }
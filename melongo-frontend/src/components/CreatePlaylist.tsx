@@ -9,6 +9,19 @@ interface Playlist {
     public: boolean;  // Indicates if the playlist is public or private
 }
 
+interface MissingTrack {
+    rank: number;
+    title: string;
+    artist: string;
+}
+
+interface MelonTop100Response {
+    status: string;
+    added?: number;
+    missingTracks?: MissingTrack[];
+    operationID?: number;
+}
+
 interface CreatePlaylistResponse {
     spotifyPlaylistID: string;
     externalUrl: string;
@@ -29,7 +42,16 @@ const CreatePlaylist: React.FC = () => {
     // State for Add Melon Songs to Playlist form
     const [melonPlaylistId, setMelonPlaylistId] = useState<string>('');
     const [melonDate, setMelonDate] = useState<string>(''); // New state for melon date
+    const [includePendingMatches, setIncludePendingMatches] = useState<boolean>(false); // Include low confidence matches
+    const [fromRank, setFromRank] = useState<string>(''); // Optional rank range
+    const [toRank, setToRank] = useState<string>('');
+    const [limit, setLimit] = useState<string>(''); // Optional max number of tracks
+    const [excludeArtists, setExcludeArtists] = useState<string>(''); // Comma separated artist names
+    const [freshOnly, setFreshOnly] = useState<boolean>(false); // Skip tracks already added to my playlists
+    const [freshWithinDays, setFreshWithinDays] = useState<string>('');
     const [melonStatus, setMelonStatus] = useState<string>('');
+    const [missingTracks, setMissingTracks] = useState<MissingTrack[]>([]);
+    const [operationID, setOperationID] = useState<number>(0); // Last add which can be undone
 
     // Handle input changes for playlist name, description, and public toggle
     const handleInputChange = (e: React.ChangeEvent<HTMLInputElement>, field: 'name' | 'description') => {
@@ -89,20 +111,30 @@ const CreatePlaylist: React.FC = () => {
 
         setLoading(true);
         setMelonStatus('');
+        setMissingTracks([]);
         try {
             // Send a request to add Melon Top 100 songs to the playlist
-            const response = await axios.post(
+            const response = await axios.post<MelonTop100Response>(
                 'http://localhost:8080/melonTop100/create', // Replace with your backend endpoint
                 {
                     playlistID: melonPlaylistId, // The playlist ID entered by the user
                     date: melonDate, // Include the date in the request body
+                    includePendingMatches: includePendingMatches,
+                    fromRank: Number(fromRank) || 0,
+                    toRank: Number(toRank) || 0,
+                    limit: Number(limit) || 0,
+                    excludeArtists: excludeArtists.split(',').map((artist) => artist.trim()).filter((artist) => artist),
+                    freshOnly: freshOnly,
+                    freshWithinDays: Number(freshWithinDays) || 0,
                 },
                 {
                     withCredentials: true,
                 }
             );
 
-            setMelonStatus('Melon Top 100 songs added to playlist successfully!');
+            setMelonStatus(response.data.status);
+            setMissingTracks(response.data.missingTracks || []);
+            setOperationID(response.data.operationID || 0);
         } catch (err) {
             setMelonStatus('Failed to add Melon Top 100 songs to playlist.');
         } finally {
@@ -110,6 +142,26 @@ const CreatePlaylist: React.FC = () => {
         }
     };
 
+    // Remove the songs added by the last Melon Top 100 request, ex. when it was added to the wrong playlist
+    const handleUndoMelonSongs = async () => {
+        setLoading(true);
+        try {
+            const response = await axios.post(
+                'http://localhost:8080/operations/undo',
+                { id: operationID },
+                {
+                    withCredentials: true,
+                }
+            );
+            setMelonStatus(response.data.status);
+            setOperationID(0);
+        } catch (err) {
+            setMelonStatus('Failed to undo. The playlist may have changed since the songs were added.');
+        } finally {
+            setLoading(false);
+        }
+    };
+
     return (
         <div className="create-playlist-container">
             <h2>Create New Playlist</h2>
@@ -200,12 +252,109 @@ const CreatePlaylist: React.FC = () => {
                     />
                 </div>
 
+                {/* Optional rank range and number of tracks. ex) Top 10 or ranks 51-100 */}
+                <div className="input-group">
+                    <label>Ranks (optional):</label>
+                    <input
+                        type="number"
+                        min={1}
+                        max={100}
+                        value={fromRank}
+                        onChange={(e) => setFromRank(e.target.value)}
+                        placeholder="From"
+                        disabled={loading}
+                    />
+                    <input
+                        type="number"
+                        min={1}
+                        max={100}
+                        value={toRank}
+                        onChange={(e) => setToRank(e.target.value)}
+                        placeholder="To"
+                        disabled={loading}
+                    />
+                </div>
+
+                <div className="input-group">
+                    <label>Max Tracks (optional):</label>
+                    <input
+                        type="number"
+                        min={1}
+                        value={limit}
+                        onChange={(e) => setLimit(e.target.value)}
+                        placeholder="All tracks"
+                        disabled={loading}
+                    />
+                </div>
+
+                <div className="input-group">
+                    <label>Exclude Artists (optional):</label>
+                    <input
+                        type="text"
+                        value={excludeArtists}
+                        onChange={(e) => setExcludeArtists(e.target.value)}
+                        placeholder="Comma separated artist names"
+                        disabled={loading}
+                    />
+                </div>
+
+                {/* Low confidence matches are left out until reviewed */}
+                <div className="input-group checkbox-container">
+                    <label className="checkbox-label">
+                        <input
+                            type="checkbox"
+                            checked={includePendingMatches}
+                            onChange={(e) => setIncludePendingMatches(e.target.checked)}
+                            disabled={loading}
+                        />
+                        Include matches pending review
+                    </label>
+                </div>
+
+                {/* Only add the songs that weren't added to my playlists before */}
+                <div className="input-group checkbox-container">
+                    <label className="checkbox-label">
+                        <input
+                            type="checkbox"
+                            checked={freshOnly}
+                            onChange={(e) => setFreshOnly(e.target.checked)}
+                            disabled={loading}
+                        />
+                        Fresh songs only
+                    </label>
+                    {freshOnly && (
+                        <input
+                            type="number"
+                            min={1}
+                            value={freshWithinDays}
+                            onChange={(e) => setFreshWithinDays(e.target.value)}
+                            placeholder="Not received within N days (all time if empty)"
+                            disabled={loading}
+                        />
+                    )}
+                </div>
+
                 <button onClick={handleAddMelonSongsToPlaylist} disabled={loading}>
                     {loading ? 'Adding Songs...' : 'Add Melon Top 100 Songs'}
                 </button>
 
                 {/* Status Message for Melon Songs Addition */}
                 {melonStatus && <p className="status">{melonStatus}</p>}
+
+                {operationID > 0 && (
+                    <button onClick={handleUndoMelonSongs} disabled={loading}>
+                        Undo
+                    </button>
+                )}
+
+                {/* Ranks which couldn't be found on spotify */}
+                {missingTracks.length > 0 && (
+                    <ul className="missing-tracks">
+                        {missingTracks.map((track) => (
+                            <li key={track.rank}>#{track.rank} {track.title} - {track.artist}</li>
+                        ))}
+                    </ul>
+                )}
             </div>
         </div>
     );
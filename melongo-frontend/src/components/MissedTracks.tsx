@@ -9,6 +9,7 @@ interface MissedTrack {
     artist: string; // The original artist name (from the backend)
     editedArtist: string; // The edited artist name (updated by the user)
     date: string;
+    daysMissed?: number; // Number of chart days the track has been missed
 }
 
 const getTodayDate = (): string => {
@@ -102,7 +103,14 @@ const MissedTracks: React.FC = () => {
                 }
             );
 
-            setStatus('Resolving missed tracks... Please check again');
+            // Large batches are resolved in the background and only return the jobID
+            if (response.data && response.data.jobID) {
+                setStatus(`${response.data.status}. Please check again later`);
+            } else {
+                const failed = (response.data.results || []).filter((result: any) => !result.resolved);
+                const failedTitles = failed.map((result: any) => `${result.missed_title} (${result.error})`).join(', ');
+                setStatus(failed.length > 0 ? `${response.data.status}. Failed: ${failedTitles}` : response.data.status);
+            }
             setMissedTracks([]); // Clear missed tracks
         } catch (err) {
             setStatus('Failed to resolve missed tracks');
@@ -138,6 +146,9 @@ const MissedTracks: React.FC = () => {
                     <li key={index}>
                         <div>
                             <strong>Rank: {track.rank}</strong>
+                            {track.daysMissed && track.daysMissed > 1 && (
+                                <span> (missed for {track.daysMissed} days)</span>
+                            )}
                         </div>
                         <div>
                             <label>Original Title:</label>
@@ -6,8 +6,11 @@ import './Home.css';
 interface Track {
   title: string;
   artist: string;
+  album?: string;
   popularity: number;
   uri: string;
+  addedAt?: string;
+  available?: boolean;
 }
 
 interface Playlist {
@@ -31,6 +34,7 @@ const Home: React.FC = () => {
   const [nextPageUrl, setNextPageUrl] = useState<string | null>(null);
   const [detailedTracks, setDetailedTracks] = useState<Track[]>([]); // New state for tracks
   const [showModal, setShowModal] = useState<boolean>(false); // State to toggle modal visibility
+  const [reportedUris, setReportedUris] = useState<string[]>([]); // Tracks reported as a wrong match
 
   // Fetch user's playlists
   useEffect(() => {
@@ -54,20 +58,32 @@ const Home: React.FC = () => {
   }, []);
 
   // Fetch detailed tracks for a playlist when button is clicked
-  const fetchDetailedTracks = async (event: React.MouseEvent<HTMLButtonElement>, tracksEndpoint: string, totalTracks: number) => {
+  const fetchDetailedTracks = async (event: React.MouseEvent<HTMLButtonElement>, playlistID: string) => {
     event.preventDefault(); // Prevent page reload on button click
     setError('');
     try {
-      const response = await axios.get(`http://localhost:8080/playlist/tracks?endpoint=${tracksEndpoint}`, {
+      const response = await axios.get(`http://localhost:8080/playlists/${playlistID}`, {
         withCredentials: true,
       });
-      setDetailedTracks(response.data.playlistTracks); // Store tracks in state
+      setDetailedTracks(response.data.playlist.tracks ?? []); // Store tracks in state
       setShowModal(true); // Show modal with tracks
     } catch (err) {
       setError('Failed to fetch detailed tracks');
     }
   };
 
+  // Report the track when the spotify track isn't the melon chart song
+  const reportWrongMatch = async (uri: string) => {
+    try {
+      await axios.post('http://localhost:8080/tracks/report', { uri }, {
+        withCredentials: true,
+      });
+      setReportedUris((prev) => [...prev, uri]);
+    } catch (err) {
+      setError('Failed to report the track');
+    }
+  };
+
   // Close the modal
   const closeModal = () => {
     setShowModal(false);
@@ -108,7 +124,7 @@ const Home: React.FC = () => {
                       Open Playlist on Spotify
                     </a>
                     <button
-                      onClick={(event) => fetchDetailedTracks(event, playlist.tracksEndpoint, playlist.totalTracks)}
+                      onClick={(event) => fetchDetailedTracks(event, playlist.spotifyPlaylistID)}
                       className="fetch-detailed-tracks-button"
                       disabled={!playlist.totalTracks}
                     >
@@ -140,7 +156,16 @@ const Home: React.FC = () => {
               {detailedTracks.map((track, index) => (
                 <li key={index} className="track-item">
                   <p><strong>{track.title}</strong> by {track.artist}</p>
-                  <p>Popularity: {track.popularity}</p>
+                  {track.album && <p>Album: {track.album}</p>}
+                  <p>Popularity: {track.popularity ?? 0}</p>
+                  {!track.available && <p className="error-message">Unavailable</p>}
+                  <button
+                    className="report-track-button"
+                    onClick={() => reportWrongMatch(track.uri)}
+                    disabled={reportedUris.includes(track.uri)}
+                  >
+                    {reportedUris.includes(track.uri) ? 'Reported' : 'Report wrong match'}
+                  </button>
                 </li>
               ))}
             </ul>
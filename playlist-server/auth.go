@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const authServerAddress = "localhost:50001"
+
+// getUserAccessToken gets a valid access token of the user from the auth server
+// Used when the user isn't the one making the request (the scheduled jobs and the admin backfill of resolved tracks)
+func getUserAccessToken(userID string) (string, error) {
+	conn, err := grpc.Dial(authServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		slog.Error("Error during gRPC dial to the auth server", "error", err)
+		return "", fmt.Errorf("failed to connect to auth server: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewAuthServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// The auth server only gives out the user tokens to the services with the shared secret
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-service-secret", os.Getenv("SERVICE_SECRET"))
+
+	response, err := client.GetUserToken(ctx, &proto.GetUserTokenRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		slog.Error("Error getting user token from the auth server", "userID", userID, "error", err)
+		return "", err
+	}
+
+	return response.AccessToken, nil
+}
@@ -0,0 +1,332 @@
+// matcheval measures how accurately the melon chart songs are matched to the spotify tracks.
+//
+// It runs spotify.SearchTrack over a labeled dataset of melon entries with the known correct spotify URIs
+// and prints the precision, the recall and the regressions against a baseline file.
+// Matches below the review threshold aren't used by the chart until reviewed, so they're reported separately
+// and don't count as predicted. The spotify responses are replayed from the recordings file so it runs offline.
+//
+// The flags default to the synthetic files in cmd/matcheval/testdata, so run it from the playlist-server directory.
+// The synthetic dataset and recordings are hand-written with made-up URIs and ISRCs. They only check that the tool
+// runs and their numbers say nothing about the matcher, so there's no baseline for them.
+// Build a dataset from a real melon chart and record the spotify responses for the real results.
+//
+// Usage:
+//
+//	# smoke test with the synthetic files
+//	go run ./cmd/matcheval
+//
+//	# record the spotify responses of another dataset (needs a valid spotify access token)
+//	SPOTIFY_ACCESS_TOKEN=... go run ./cmd/matcheval -dataset dataset.json -recordings recordings.json -record
+//
+//	# evaluate offline and save the results as the baseline
+//	go run ./cmd/matcheval -dataset dataset.json -recordings recordings.json -baseline baseline.json -write-baseline
+//
+//	# after changing formatTitle, formatArtistName, MatchConfidence or SearchTrack, compare against the baseline
+//	go run ./cmd/matcheval -dataset dataset.json -recordings recordings.json -baseline baseline.json
+//
+// The dataset is a JSON array of the melon entries. expectedURI is empty when the song isn't on spotify
+//
+//	[{"rank": 1, "title": "...", "artist": "...", "album": "...", "expectedURI": "spotify:track:..."}]
+//
+// Exits with 1 when there are regressions against the baseline.
+// Requests changed by the matcher (ex. a new search query) aren't in the recordings, so record them again with -record.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+)
+
+// labeledEntry is a melon entry with the correct spotify URI
+type labeledEntry struct {
+	Rank        int32  `json:"rank"`
+	Title       string `json:"title"`
+	Artist      string `json:"artist"`
+	Album       string `json:"album"`
+	ExpectedURI string `json:"expectedURI"`
+}
+
+// matchResult is the result of matching a single entry. Also saved as the baseline
+type matchResult struct {
+	Title        string  `json:"title"`
+	Artist       string  `json:"artist"`
+	ExpectedURI  string  `json:"expectedURI"`
+	PredictedURI string  `json:"predictedURI"`
+	Confidence   float64 `json:"confidence"`
+	Error        string  `json:"error,omitempty"`
+	// below the review threshold so the chart wouldn't use the predicted URI until reviewed
+	PendingReview bool `json:"pendingReview"`
+	// predicted the expected URI, or no track when the song isn't on spotify
+	Correct bool `json:"correct"`
+}
+
+type evaluation struct {
+	Total     int `json:"total"`
+	Predicted int `json:"predicted"`
+	Expected  int `json:"expected"`
+	Correct   int `json:"correct"`
+	// matches below the review threshold and how many of them are the expected track
+	PendingReview  int           `json:"pendingReview"`
+	PendingCorrect int           `json:"pendingCorrect"`
+	Precision      float64       `json:"precision"`
+	Recall         float64       `json:"recall"`
+	Results        []matchResult `json:"results"`
+}
+
+// replayAccessToken is used when replaying since SearchTrack requires a token
+const replayAccessToken = "replay"
+
+// Synthetic files in testdata. They aren't real spotify responses
+const (
+	defaultDatasetPath    = "cmd/matcheval/testdata/synthetic_dataset.json"
+	defaultRecordingsPath = "cmd/matcheval/testdata/synthetic_recordings.json"
+)
+
+func main() {
+	datasetPath := flag.String("dataset", defaultDatasetPath, "labeled dataset of the melon entries (JSON)")
+	recordingsPath := flag.String("recordings", defaultRecordingsPath, "recorded spotify responses (JSON)")
+	baselinePath := flag.String("baseline", "", "results of the previous evaluation to find the regressions. Empty to skip")
+	record := flag.Bool("record", false, "call spotify and save the responses to the recordings file")
+	writeBaseline := flag.Bool("write-baseline", false, "save the results as the baseline")
+	verbose := flag.Bool("v", false, "print the logs of the matcher and every wrong match")
+	flag.Parse()
+
+	if *datasetPath == "" || *recordingsPath == "" || (*writeBaseline && *baselinePath == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	level := slog.LevelWarn
+	if *verbose {
+		level = slog.LevelInfo
+	}
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
+
+	accessToken := replayAccessToken
+	if *record {
+		accessToken = os.Getenv("SPOTIFY_ACCESS_TOKEN")
+		if accessToken == "" {
+			fmt.Fprintln(os.Stderr, "SPOTIFY_ACCESS_TOKEN is required to record the spotify responses")
+			os.Exit(2)
+		}
+	}
+
+	entries, err := readDataset(*datasetPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	rec, err := newRecorder(*recordingsPath, *record)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	spotify.SetHTTPClient(&http.Client{Transport: rec})
+
+	result := evaluate(entries, accessToken)
+
+	if *record {
+		if err := rec.save(*recordingsPath); err != nil {
+			fmt.Fprintf(os.Stderr, "error saving recordings: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Recorded %d spotify responses to %s\n", len(rec.responses), *recordingsPath)
+	}
+
+	printEvaluation(result, *verbose)
+	if len(rec.missing) > 0 {
+		fmt.Printf("\n%d requests weren't recorded. Run with -record to update %s\n", len(rec.missing), *recordingsPath)
+	}
+
+	if *baselinePath == "" {
+		return
+	}
+
+	if *writeBaseline {
+		if err := writeEvaluation(*baselinePath, result); err != nil {
+			fmt.Fprintf(os.Stderr, "error saving baseline: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("\nSaved baseline to %s\n", *baselinePath)
+		return
+	}
+
+	baseline, err := readEvaluation(*baselinePath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	regressions := compareBaseline(baseline, result)
+	if regressions > 0 {
+		os.Exit(1)
+	}
+}
+
+func readDataset(path string) ([]labeledEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading dataset: %v", err)
+	}
+
+	var entries []labeledEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("error parsing dataset: %v", err)
+	}
+	return entries, nil
+}
+
+func readEvaluation(path string) (*evaluation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading baseline: %v", err)
+	}
+
+	var result evaluation
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("error parsing baseline: %v", err)
+	}
+	return &result, nil
+}
+
+func writeEvaluation(path string, result *evaluation) error {
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// evaluate matches every entry the same way the chart is saved (first result of SearchTrack).
+// Like processSong, a match below the review threshold isn't used so it's counted as no prediction
+func evaluate(entries []labeledEntry, accessToken string) *evaluation {
+	result := &evaluation{Total: len(entries)}
+
+	for _, entry := range entries {
+		res := matchResult{
+			Title:       entry.Title,
+			Artist:      entry.Artist,
+			ExpectedURI: entry.ExpectedURI,
+		}
+
+		track, err := spotify.SearchTrack(entry.Title, entry.Artist, accessToken)
+		if err != nil {
+			res.Error = err.Error()
+		} else if track != nil {
+			res.PredictedURI = track.URI
+			res.Confidence = spotify.MatchConfidence(entry.Title, entry.Artist, track)
+			res.PendingReview = res.Confidence < spotify.MatchReviewThreshold
+		}
+
+		if res.PendingReview {
+			result.PendingReview++
+			if res.PredictedURI == res.ExpectedURI {
+				result.PendingCorrect++
+			}
+			res.Correct = res.ExpectedURI == ""
+		} else {
+			res.Correct = res.PredictedURI == res.ExpectedURI
+			if res.PredictedURI != "" {
+				result.Predicted++
+			}
+		}
+		if res.ExpectedURI != "" {
+			result.Expected++
+			if res.Correct {
+				result.Correct++
+			}
+		}
+
+		result.Results = append(result.Results, res)
+	}
+
+	if result.Predicted > 0 {
+		result.Precision = float64(result.Correct) / float64(result.Predicted)
+	}
+	if result.Expected > 0 {
+		result.Recall = float64(result.Correct) / float64(result.Expected)
+	}
+	return result
+}
+
+func printEvaluation(result *evaluation, verbose bool) {
+	fmt.Printf("Entries:   %d (%d on spotify)\n", result.Total, result.Expected)
+	fmt.Printf("Matched:   %d\n", result.Predicted)
+	fmt.Printf("Correct:   %d\n", result.Correct)
+	fmt.Printf("Pending:   %d below %.2f (%d of them correct)\n", result.PendingReview, spotify.MatchReviewThreshold, result.PendingCorrect)
+	fmt.Printf("Precision: %.4f\n", result.Precision)
+	fmt.Printf("Recall:    %.4f\n", result.Recall)
+
+	if !verbose {
+		return
+	}
+
+	fmt.Println("\nWrong matches:")
+	for _, res := range result.Results {
+		if !res.Correct && !res.PendingReview {
+			printResult(res)
+		}
+	}
+
+	fmt.Println("\nPending review:")
+	for _, res := range result.Results {
+		if res.PendingReview {
+			printResult(res)
+		}
+	}
+}
+
+func printResult(res matchResult) {
+	fmt.Printf("  %s - %s: expected %q, got %q (confidence %.2f)", res.Title, res.Artist, res.ExpectedURI, res.PredictedURI, res.Confidence)
+	if res.PendingReview {
+		fmt.Printf(" pending review")
+	}
+	if res.Error != "" {
+		fmt.Printf(" error: %s", res.Error)
+	}
+	fmt.Println()
+}
+
+// compareBaseline prints the entries that were correct in the baseline but not anymore (regressions)
+// and the other way around (fixed). Returns the number of regressions
+func compareBaseline(baseline *evaluation, result *evaluation) int {
+	previous := make(map[string]matchResult, len(baseline.Results))
+	for _, res := range baseline.Results {
+		previous[res.Title+"\x00"+res.Artist] = res
+	}
+
+	var regressions, fixed []matchResult
+	for _, res := range result.Results {
+		prev, ok := previous[res.Title+"\x00"+res.Artist]
+		if !ok {
+			continue
+		}
+		if prev.Correct && !res.Correct {
+			regressions = append(regressions, res)
+		}
+		if !prev.Correct && res.Correct {
+			fixed = append(fixed, res)
+		}
+	}
+
+	fmt.Printf("\nBaseline:  precision %.4f (%+.4f), recall %.4f (%+.4f)\n", baseline.Precision, result.Precision-baseline.Precision, baseline.Recall, result.Recall-baseline.Recall)
+
+	fmt.Printf("\nRegressions: %d\n", len(regressions))
+	for _, res := range regressions {
+		printResult(res)
+	}
+
+	fmt.Printf("\nFixed: %d\n", len(fixed))
+	for _, res := range fixed {
+		printResult(res)
+	}
+
+	return len(regressions)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+)
+
+// recordDeliveredTracks remembers that the tracks were added to the user's playlist so that the fresh only
+// playlists can skip them later. Failing to record is only logged since the tracks are already in the playlist
+func (playlistServer *PlaylistServer) recordDeliveredTracks(userID string, playlistID string, uris []string) {
+	if userID == "" || len(uris) == 0 {
+		return
+	}
+
+	// The same track can't be upserted twice in a single statement
+	seen := make(map[string]bool, len(uris))
+	uniqueURIs := make([]string, 0, len(uris))
+	for _, uri := range uris {
+		if !seen[uri] {
+			seen[uri] = true
+			uniqueURIs = append(uniqueURIs, uri)
+		}
+	}
+
+	err := playlistServer.DB.CreateDeliveredTracks(context.Background(), database.CreateDeliveredTracksParams{
+		UserID:     userID,
+		Uris:       uniqueURIs,
+		PlaylistID: playlistID,
+	})
+	if err != nil {
+		slog.Error("Error recording the delivered tracks", "userID", userID, "playlistID", playlistID, "tracks", len(uniqueURIs), "error", err)
+	}
+}
+
+// getDeliveredTracks returns the tracks delivered to the user within the last withinDays days.
+// Every track ever delivered is returned when withinDays is 0
+func (playlistServer *PlaylistServer) getDeliveredTracks(ctx context.Context, userID string, withinDays int32) (map[string]bool, error) {
+	var since time.Time
+	if withinDays > 0 {
+		since = time.Now().AddDate(0, 0, -int(withinDays))
+	}
+
+	uris, err := playlistServer.DB.ListDeliveredTrackURIs(ctx, database.ListDeliveredTrackURIsParams{
+		UserID:         userID,
+		DeliveredSince: since,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	delivered := make(map[string]bool, len(uris))
+	for _, uri := range uris {
+		delivered[uri] = true
+	}
+	return delivered, nil
+}
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+	"github.com/akimdev15/mscraper"
+)
+
+// How the favorite artist was added
+const (
+	favoriteSourceManual   = "manual"
+	favoriteSourceFollowed = "followed" // followed artists on spotify
+	favoriteSourceTop      = "top"      // top artists on spotify
+)
+
+// Charts that can be filtered by the favorite artists
+const (
+	favoriteChartTop100      = "top100"       // saved melon top 100 of the date
+	favoriteChartGenre       = "genre"        // newest songs of the melon genre
+	favoriteChartNewReleases = "new_releases" // newest albums on melon
+)
+
+// AddFavoriteArtist resolves the artist name to the spotify artist and adds it to the user's favorite artists
+func (playlistServer *PlaylistServer) AddFavoriteArtist(ctx context.Context, req *proto.AddFavoriteArtistRequest) (*proto.AddFavoriteArtistResponse, error) {
+	if req.UserID == "" || strings.TrimSpace(req.ArtistName) == "" {
+		return nil, fmt.Errorf("userID and artistName are required")
+	}
+
+	artist, err := spotify.SearchArtistID(strings.TrimSpace(req.ArtistName), req.AccessToken)
+	if err != nil {
+		slog.Error("Error searching the artist", "artistName", req.ArtistName, "error", err)
+		return nil, fmt.Errorf("error searching the artist: %v", err)
+	}
+	if artist.ID == "" {
+		return nil, fmt.Errorf("artist not found on spotify: %s", req.ArtistName)
+	}
+
+	favoriteArtist, err := playlistServer.DB.CreateFavoriteArtist(ctx, database.CreateFavoriteArtistParams{
+		UserID:          req.UserID,
+		SpotifyArtistID: artist.ID,
+		Name:            artist.Name,
+		Source:          favoriteSourceManual,
+	})
+	if err != nil {
+		slog.Error("Error saving favorite artist", "artistID", artist.ID, "error", err)
+		return nil, fmt.Errorf("error saving favorite artist: %v", err)
+	}
+
+	return &proto.AddFavoriteArtistResponse{
+		Artist: convertFavoriteArtistToProto(favoriteArtist),
+	}, nil
+}
+
+func (playlistServer *PlaylistServer) ListFavoriteArtists(ctx context.Context, req *proto.ListFavoriteArtistsRequest) (*proto.ListFavoriteArtistsResponse, error) {
+	favoriteArtists, err := playlistServer.DB.ListFavoriteArtists(ctx, req.UserID)
+	if err != nil {
+		slog.Error("Error listing favorite artists", "userID", req.UserID, "error", err)
+		return nil, fmt.Errorf("error listing favorite artists: %v", err)
+	}
+
+	artists := make([]*proto.FavoriteArtist, 0, len(favoriteArtists))
+	for _, favoriteArtist := range favoriteArtists {
+		artists = append(artists, convertFavoriteArtistToProto(favoriteArtist))
+	}
+
+	return &proto.ListFavoriteArtistsResponse{
+		Artists: artists,
+	}, nil
+}
+
+func (playlistServer *PlaylistServer) RemoveFavoriteArtist(ctx context.Context, req *proto.RemoveFavoriteArtistRequest) (*proto.RemoveFavoriteArtistResponse, error) {
+	favoriteArtist, err := playlistServer.DB.DeleteFavoriteArtist(ctx, database.DeleteFavoriteArtistParams{
+		UserID:          req.UserID,
+		SpotifyArtistID: req.SpotifyArtistID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("favorite artist not found: %s", req.SpotifyArtistID)
+	} else if err != nil {
+		slog.Error("Error deleting favorite artist", "artistID", req.SpotifyArtistID, "error", err)
+		return nil, err
+	}
+
+	return &proto.RemoveFavoriteArtistResponse{
+		Artist: convertFavoriteArtistToProto(favoriteArtist),
+	}, nil
+}
+
+// ImportFavoriteArtists adds the user's followed or top artists on spotify to the favorite artists
+func (playlistServer *PlaylistServer) ImportFavoriteArtists(ctx context.Context, req *proto.ImportFavoriteArtistsRequest) (*proto.ImportFavoriteArtistsResponse, error) {
+	var artists []spotify.ArtistItem
+	var err error
+
+	switch req.Source {
+	case favoriteSourceFollowed:
+		artists, err = spotify.GetFollowedArtists(req.AccessToken)
+	case favoriteSourceTop:
+		artists, err = spotify.GetTopArtists(req.AccessToken)
+	default:
+		return nil, fmt.Errorf("unsupported import source: %s", req.Source)
+	}
+	if err != nil {
+		slog.Error("Error getting the artists from spotify", "source", req.Source, "error", err)
+		return nil, fmt.Errorf("error getting the %s artists: %v", req.Source, err)
+	}
+
+	response := &proto.ImportFavoriteArtistsResponse{}
+	for _, artist := range artists {
+		favoriteArtist, err := playlistServer.DB.CreateFavoriteArtist(ctx, database.CreateFavoriteArtistParams{
+			UserID:          req.UserID,
+			SpotifyArtistID: artist.ID,
+			Name:            artist.Name,
+			Source:          req.Source,
+		})
+		if err != nil {
+			slog.Error("Error saving favorite artist", "artistID", artist.ID, "error", err)
+			continue
+		}
+		response.Artists = append(response.Artists, convertFavoriteArtistToProto(favoriteArtist))
+	}
+
+	response.Imported = int32(len(response.Artists))
+	slog.Info("Imported favorite artists", "userID", req.UserID, "source", req.Source, "imported", response.Imported)
+
+	return response, nil
+}
+
+// CreateFavoriteArtistsPlaylist adds the chart entries by the user's favorite artists to the playlist.
+// The saved top 100 is filtered right away. Genre charts and new releases are searched on spotify after the response
+func (playlistServer *PlaylistServer) CreateFavoriteArtistsPlaylist(ctx context.Context, req *proto.CreateFavoriteArtistsPlaylistRequest) (*proto.CreateFavoriteArtistsPlaylistResponse, error) {
+	favoriteArtists, err := playlistServer.DB.ListFavoriteArtists(ctx, req.UserID)
+	if err != nil {
+		slog.Error("Error listing favorite artists", "userID", req.UserID, "error", err)
+		return nil, fmt.Errorf("error listing favorite artists: %v", err)
+	}
+	if len(favoriteArtists) == 0 {
+		return nil, fmt.Errorf("no favorite artists. Add or import the artists first")
+	}
+
+	favorites := newFavoriteArtistSet(favoriteArtists)
+
+	switch req.Source {
+	case favoriteChartTop100:
+		date, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			slog.Error("[CreateFavoriteArtistsPlaylist] - Invalid date format", "error", err)
+			return nil, fmt.Errorf("invalid date format: %v", err)
+		}
+
+		songs, err := playlistServer.DB.GetTracksByDate(ctx, date)
+		if err != nil || len(songs) == 0 {
+			slog.Error("[CreateFavoriteArtistsPlaylist] - Error getting tracks by date. Need to save top 100 to DB first", "error", err)
+			return nil, fmt.Errorf("error getting tracks by date: %v", err)
+		}
+
+		var uris []string
+		for _, song := range songs {
+			if song.ReviewStatus == reviewStatusPending {
+				continue
+			}
+			// Chart tracks only have the artist names. The melon artist is checked too for the korean names
+			if favorites.containsArtistNames(song.Artist) || favorites.containsArtistNames(song.MelonArtist) {
+				uris = append(uris, song.Uri)
+			}
+		}
+
+		if _, err := playlistServer.addTracksToUserPlaylist(ctx, req.UserID, req.PlaylistID, operationFavoriteArtists, uris, req.AccessToken); err != nil {
+			return nil, err
+		}
+
+		return &proto.CreateFavoriteArtistsPlaylistResponse{
+			Status:      fmt.Sprintf("Added %d tracks by the favorite artists from %d chart tracks", len(uris), len(songs)),
+			TracksAdded: int32(len(uris)),
+		}, nil
+
+	case favoriteChartGenre:
+		if req.Genre == "" {
+			return nil, fmt.Errorf("genre is required")
+		}
+		go playlistServer.addFavoriteArtistGenreSongs(req, favorites)
+
+	case favoriteChartNewReleases:
+		go playlistServer.addFavoriteArtistNewReleases(req, favorites)
+
+	default:
+		return nil, fmt.Errorf("unsupported chart source: %s", req.Source)
+	}
+
+	return &proto.CreateFavoriteArtistsPlaylistResponse{
+		Status: fmt.Sprintf("Searching the %s songs by %d favorite artists", req.Source, len(favoriteArtists)),
+	}, nil
+}
+
+// addFavoriteArtistGenreSongs searches the newest songs of the genre and adds the ones by the favorite artists
+func (playlistServer *PlaylistServer) addFavoriteArtistGenreSongs(req *proto.CreateFavoriteArtistsPlaylistRequest, favorites favoriteArtistSet) {
+	songs := mscraper.GetNewestSongsMelon(req.Genre)
+
+	var uris []string
+	for _, song := range songs {
+		track, err := spotify.SearchTrack(song.Title, song.Artist, req.AccessToken)
+		if err != nil || track == nil || track.URI == "" {
+			continue
+		}
+		if favorites.containsIDs(track.ArtistIDs) {
+			uris = append(uris, track.URI)
+		}
+	}
+
+	if _, err := playlistServer.addTracksToUserPlaylist(context.Background(), req.UserID, req.PlaylistID, operationFavoriteArtists, uris, req.AccessToken); err != nil {
+		slog.Error("[CreateFavoriteArtistsPlaylist] - Error adding genre songs to playlist", "genre", req.Genre, "error", err)
+		return
+	}
+	slog.Info("[CreateFavoriteArtistsPlaylist] - Added genre songs by the favorite artists", "genre", req.Genre, "songs", len(songs), "tracks", len(uris))
+}
+
+// addFavoriteArtistNewReleases adds every track of the newest albums by the favorite artists
+func (playlistServer *PlaylistServer) addFavoriteArtistNewReleases(req *proto.CreateFavoriteArtistsPlaylistRequest, favorites favoriteArtistSet) {
+	albums := mscraper.GetNewestAlbumFromMelon()
+	uris := newReleaseURIs(albums, favorites, nil, req.AccessToken)
+
+	if _, err := playlistServer.addTracksToUserPlaylist(context.Background(), req.UserID, req.PlaylistID, operationFavoriteArtists, uris, req.AccessToken); err != nil {
+		slog.Error("[CreateFavoriteArtistsPlaylist] - Error adding new releases to playlist", "error", err)
+		return
+	}
+	slog.Info("[CreateFavoriteArtistsPlaylist] - Added new releases by the favorite artists", "albums", len(albums), "tracks", len(uris))
+}
+
+// newReleaseURIs searches the tracks of the albums on spotify and returns the ones by the favorite artists.
+// Tracks in skip (ex. already delivered) are left out
+func newReleaseURIs(albums []mscraper.Album, favorites favoriteArtistSet, skip map[string]bool, accessToken string) []string {
+	var uris []string
+	seen := make(map[string]bool)
+	for _, album := range albums {
+		tracks, err := spotify.SearchTracksFromAlbum(album.Name, album.Artist, accessToken)
+		if err != nil {
+			continue
+		}
+
+		for _, track := range tracks {
+			artistIDs := make([]string, 0, len(track.Artist))
+			for _, artist := range track.Artist {
+				artistIDs = append(artistIDs, artist.ID)
+			}
+			if track.URI == "" || seen[track.URI] || skip[track.URI] || !favorites.containsIDs(artistIDs) {
+				continue
+			}
+			seen[track.URI] = true
+			uris = append(uris, track.URI)
+		}
+	}
+	return uris
+}
+
+// addTracksToPlaylistInChunks adds the tracks 100 at a time which is the most spotify accepts in a single request.
+// Returns the snapshot ID of the playlist after the last request
+func addTracksToPlaylistInChunks(playlistID string, uris []string, accessToken string) (string, error) {
+	snapshotID := ""
+	for start := 0; start < len(uris); start += 100 {
+		end := min(start+100, len(uris))
+		response, err := spotify.AddTrackToPlaylist(playlistID, uris[start:end], accessToken)
+		if err != nil {
+			slog.Error("Error adding tracks to playlist", "playlistID", playlistID, "error", err)
+			return "", err
+		}
+		snapshotID = response.SnapshotID
+	}
+	return snapshotID, nil
+}
+
+// artistSet matches the artist names of a track with the set of artists (case insensitive)
+type artistSet map[string]bool
+
+func newArtistSet(names []string) artistSet {
+	set := make(artistSet, len(names))
+	for _, name := range names {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			set[name] = true
+		}
+	}
+	return set
+}
+
+// favoriteArtistSet matches the tracks with the favorite artists by the spotify artist ID.
+// The names are only for the chart tracks saved without the artist IDs
+type favoriteArtistSet struct {
+	ids   map[string]bool
+	names []string
+}
+
+func newFavoriteArtistSet(favoriteArtists []database.FavoriteArtist) favoriteArtistSet {
+	set := favoriteArtistSet{ids: make(map[string]bool, len(favoriteArtists))}
+	for _, favoriteArtist := range favoriteArtists {
+		set.ids[favoriteArtist.SpotifyArtistID] = true
+		if name := strings.ToLower(strings.TrimSpace(favoriteArtist.Name)); name != "" {
+			set.names = append(set.names, name)
+		}
+	}
+	return set
+}
+
+// containsIDs reports whether any of the spotify artist IDs is a favorite artist
+func (set favoriteArtistSet) containsIDs(artistIDs []string) bool {
+	for _, artistID := range artistIDs {
+		if set.ids[artistID] {
+			return true
+		}
+	}
+	return false
+}
+
+// containsArtistNames reports whether any favorite artist is one of the names joined by ", " (case insensitive).
+// The favorite names are looked up as a whole so that a name with a comma (ex. Tyler, The Creator) still matches
+func (set favoriteArtistSet) containsArtistNames(joined string) bool {
+	joined = strings.ToLower(joined)
+	for _, name := range set.names {
+		if joined == name || strings.HasPrefix(joined, name+", ") || strings.HasSuffix(joined, ", "+name) || strings.Contains(joined, ", "+name+", ") {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether any of the artists is in the set
+func (set artistSet) contains(artists []string) bool {
+	for _, artist := range artists {
+		if set[strings.ToLower(strings.TrimSpace(artist))] {
+			return true
+		}
+	}
+	return false
+}
+
+func convertFavoriteArtistToProto(favoriteArtist database.FavoriteArtist) *proto.FavoriteArtist {
+	return &proto.FavoriteArtist{
+		SpotifyArtistID: favoriteArtist.SpotifyArtistID,
+		Name:            favoriteArtist.Name,
+		Source:          favoriteArtist.Source,
+	}
+}
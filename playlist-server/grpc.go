@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"net"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -21,21 +22,57 @@ import (
 
 const gRPCPORT = "50002"
 
+// Number of spotify candidates returned for each missed track by SuggestCandidates
+const (
+	defaultCandidateLimit = 5
+	maxCandidateLimit     = 20
+)
+
+// ResolveMissedTracks with more tracks than this is processed in the background as a job
+const resolveJobThreshold = 10
+
 type PlaylistServer struct {
 	proto.UnimplementedPlaylistServiceServer
 	DB     *database.Queries
 	DBConn *sql.DB
+
+	resolveJobs     *jobStore[*proto.ResolveResult]
+	melonTop100Jobs *jobStore[*proto.CreateMelonTop100Response]
 }
 
 // SongDB is a struct to store song information in the database
+// Title, Artist and Album are from the matched spotify track and Melon* fields are the original melon chart entry
 type SongDB struct {
-	Rank   int32
-	Title  string
-	Artist string
-	URI    string
-	Date   time.Time
+	Rank            int32
+	Title           string
+	Artist          string
+	Album           string
+	URI             string
+	DurationMs      int32
+	ISRC            string
+	MelonTitle      string
+	MelonArtist     string
+	MelonAlbum      string
+	MatchMethod     string
+	MatchConfidence float64
+	ReviewStatus    string
+	Date            time.Time
 }
 
+// Match methods saved with each track to know how the spotify track was found
+const (
+	matchMethodSearch    = "search"     // first result of the spotify search
+	matchMethodResolved  = "resolved"   // from the resolved_tracks DB (previously resolved missed track)
+	matchMethodManual    = "manual"     // resolved by the user through ResolveMissedTracks with title and artist
+	matchMethodManualURI = "manual_uri" // resolved by the user through ResolveMissedTracks with the exact spotify URI
+)
+
+// Review status of each track. Searched tracks below the spotify.MatchReviewThreshold are pending until reviewed with ReviewPendingMatch
+const (
+	reviewStatusApproved = "approved"
+	reviewStatusPending  = "pending"
+)
+
 func (apiCfg *apiConfig) grpcListen() {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", gRPCPORT))
 	if err != nil {
@@ -44,7 +81,17 @@ func (apiCfg *apiConfig) grpcListen() {
 	}
 
 	grpcServer := grpc.NewServer()
-	proto.RegisterPlaylistServiceServer(grpcServer, &PlaylistServer{DB: apiCfg.DB, DBConn: apiCfg.DBConn})
+	playlistServer := &PlaylistServer{
+		DB:              apiCfg.DB,
+		DBConn:          apiCfg.DBConn,
+		resolveJobs:     newJobStore[*proto.ResolveResult](),
+		melonTop100Jobs: newJobStore[*proto.CreateMelonTop100Response](),
+	}
+	proto.RegisterPlaylistServiceServer(grpcServer, playlistServer)
+
+	// Weekly release radars are updated in the background
+	go playlistServer.runReleaseRadarScheduler()
+
 	slog.Info("gRPC server start on", "PORT", gRPCPORT)
 	if err = grpcServer.Serve(lis); err != nil {
 		slog.Error("Failed to listen for gRPC", "error", err)
@@ -110,39 +157,188 @@ func (playlistServer *PlaylistServer) GetUserPlaylists(ctx context.Context, req
 	}, nil
 }
 
-func (PlaylistServer *PlaylistServer) CreateMelonTop100(ctx context.Context, req *proto.CreateMelonTop100Request) (*proto.CreateMelonTop100Response, error) {
+func (playlistServer *PlaylistServer) CreateMelonTop100(ctx context.Context, req *proto.CreateMelonTop100Request) (*proto.CreateMelonTop100Response, error) {
 	date, err := time.Parse("2006-01-02", req.Date)
 	if err != nil {
 		slog.Error("[CreateMelonTop100] - Invalid date format", "error", err)
 		return nil, fmt.Errorf("invalid date format: %v", err)
 	}
 
-	songs, err := PlaylistServer.DB.GetTracksByDate(context.Background(), date)
-	if err != nil || len(songs) == 0 {
-		slog.Error("[CreateMelonTop100] - Error getting tracks by date. Need to save top 100 to DB first", "error", err)
+	if req.FromRank < 0 || req.ToRank < 0 || req.Limit < 0 {
+		return nil, fmt.Errorf("fromRank, toRank and limit can't be negative")
+	}
+	if req.FromRank > 0 && req.ToRank > 0 && req.FromRank > req.ToRank {
+		return nil, fmt.Errorf("fromRank %d is greater than toRank %d", req.FromRank, req.ToRank)
+	}
+	if req.FreshWithinDays < 0 {
+		return nil, fmt.Errorf("freshWithinDays can't be negative")
+	}
+
+	// Both are ordered by rank so that the chart entries can be walked in the chart order
+	songs, err := playlistServer.DB.GetTracksByDate(ctx, date)
+	if err != nil {
+		slog.Error("[CreateMelonTop100] - Error getting tracks by date", "error", err)
 		return nil, fmt.Errorf("error getting tracks by date: %v", err)
 	}
+	missedTracks, err := playlistServer.DB.GetMissedTracksByDate(ctx, date)
+	if err != nil {
+		slog.Error("[CreateMelonTop100] - Error getting missed tracks by date", "error", err)
+		return nil, fmt.Errorf("error getting missed tracks by date: %v", err)
+	}
+	if len(songs) == 0 && len(missedTracks) == 0 {
+		slog.Error("[CreateMelonTop100] - No chart entries for the date. Need to save top 100 to DB first", "date", req.Date)
+		return nil, fmt.Errorf("no chart entries saved for %s", req.Date)
+	}
+
+	var delivered map[string]bool
+	if req.FreshOnly {
+		delivered, err = playlistServer.getDeliveredTracks(ctx, req.UserID, req.FreshWithinDays)
+		if err != nil {
+			slog.Error("[CreateMelonTop100] - Error getting the delivered tracks", "userID", req.UserID, "error", err)
+			return nil, fmt.Errorf("error getting the delivered tracks: %v", err)
+		}
+	}
 
+	excludedArtists := newArtistSet(req.ExcludeArtists)
+	outOfRange := func(rank int32) bool {
+		return (req.FromRank > 0 && rank < req.FromRank) || (req.ToRank > 0 && rank > req.ToRank)
+	}
+
+	response := &proto.CreateMelonTop100Response{
+		ChartEntries: int32(len(songs) + len(missedTracks)),
+	}
 	var uris []string
+	// chart rank of each of the uris
+	var ranks []int32
+
+	// Merge the saved and the missed entries by rank so that the limit also applies to the missing ranks
+	songIndex, missedIndex := 0, 0
+	for songIndex < len(songs) || missedIndex < len(missedTracks) {
+		if req.Limit > 0 && len(uris) >= int(req.Limit) {
+			break
+		}
 
-	for _, song := range songs {
+		if missedIndex < len(missedTracks) && (songIndex >= len(songs) || missedTracks[missedIndex].Rank < songs[songIndex].Rank) {
+			missedTrack := missedTracks[missedIndex]
+			missedIndex++
+			if outOfRange(missedTrack.Rank) || excludedArtists.contains([]string{missedTrack.Artist}) {
+				continue
+			}
+			response.MissingTracks = append(response.MissingTracks, convertMissedTrackToProto(missedTrack))
+			continue
+		}
+
+		song := songs[songIndex]
+		songIndex++
+		if outOfRange(song.Rank) {
+			continue
+		}
+		if excludedArtists.contains(append(strings.Split(song.Artist, ", "), song.MelonArtist)) {
+			continue
+		}
+		// Low confidence matches can be a cover or a different song so they are left out until approved
+		if song.ReviewStatus == reviewStatusPending && !req.IncludePendingMatches {
+			response.PendingReview++
+			continue
+		}
+		if delivered[song.Uri] {
+			response.AlreadyDelivered++
+			continue
+		}
 		uris = append(uris, song.Uri)
+		ranks = append(ranks, song.Rank)
 	}
 
-	// Return the response before adding tracks to the playlist
-	response := &proto.CreateMelonTop100Response{
-		Status: fmt.Sprintf("Added %d tracks and missed %d tracks", len(uris), len(songs)-len(uris)),
+	if !req.Async {
+		if err := playlistServer.addMelonTop100Tracks(req, date, uris, ranks, response); err != nil {
+			return nil, fmt.Errorf("error adding tracks to playlist: %v", err)
+		}
+		return response, nil
 	}
 
-	// Add tracks to the playlist after sending the response to reduce time
+	jobID := playlistServer.melonTop100Jobs.create(req.UserID, 1)
 	go func() {
-		_, err := spotify.AddTrackToPlaylist(req.PlaylistID, uris, req.AccessToken)
-		if err != nil {
-			slog.Error("[CreateMelonTop100] - Error adding tracks to playlist", "error", err)
+		defer playlistServer.melonTop100Jobs.finish(jobID)
+
+		result := &proto.CreateMelonTop100Response{
+			ChartEntries:     response.ChartEntries,
+			MissingTracks:    response.MissingTracks,
+			PendingReview:    response.PendingReview,
+			AlreadyDelivered: response.AlreadyDelivered,
+			JobID:            jobID,
 		}
-		slog.Info("[CreateMelonTop100] - Asynchronously added tracks to the playlist", "playlistID", req.PlaylistID, "tracks", len(uris))
+		if err := playlistServer.addMelonTop100Tracks(req, date, uris, ranks, result); err != nil {
+			result.Error = err.Error()
+		}
+		playlistServer.melonTop100Jobs.addResult(jobID, result)
 	}()
 
+	response.JobID = jobID
+	response.Status = fmt.Sprintf("Adding %d tracks in the background", len(uris))
+	return response, nil
+}
+
+// addMelonTop100Tracks adds the chart tracks to the playlist and fills in the result of the response
+func (playlistServer *PlaylistServer) addMelonTop100Tracks(req *proto.CreateMelonTop100Request, date time.Time, uris []string, ranks []int32, response *proto.CreateMelonTop100Response) error {
+	playlistOperation, err := playlistServer.addTracksToUserPlaylist(context.Background(), req.UserID, req.PlaylistID, operationMelonTop100, uris, req.AccessToken)
+	if err != nil {
+		slog.Error("[CreateMelonTop100] - Error adding tracks to playlist", "playlistID", req.PlaylistID, "error", err)
+		response.Status = fmt.Sprintf("Failed to add tracks to the playlist: %v", err)
+		return err
+	}
+
+	response.Added = int32(len(uris))
+	response.SnapshotID = playlistOperation.SpotifySnapshotID
+	response.OperationID = playlistOperation.ID
+	response.Status = fmt.Sprintf("Added %d tracks. %d missing from spotify", response.Added, len(response.MissingTracks))
+	if response.PendingReview > 0 {
+		response.Status += fmt.Sprintf(", %d pending review", response.PendingReview)
+	}
+	if response.AlreadyDelivered > 0 {
+		response.Status += fmt.Sprintf(", %d already delivered", response.AlreadyDelivered)
+	}
+	slog.Info("[CreateMelonTop100] - Added tracks to the playlist", "playlistID", req.PlaylistID, "tracks", len(uris))
+
+	// Resolved tracks are inserted at their chart position so only the full chart playlists are remembered.
+	// Fresh only playlists are left out too since the resolved track isn't checked against the delivered tracks
+	if req.FromRank > 0 || req.ToRank > 0 || req.Limit > 0 || len(req.ExcludeArtists) > 0 || req.FreshOnly {
+		return nil
+	}
+	// Without any track added there's no position in the playlist to insert the resolved tracks from
+	if len(playlistOperation.Positions) == 0 {
+		return nil
+	}
+
+	// Remember the playlist with where the chart starts in it and the ranks of the added tracks
+	// so that the missed tracks of the date can be inserted at their chart position once they are resolved
+	err = playlistServer.DB.CreatePlaylistBuild(context.Background(), database.CreatePlaylistBuildParams{
+		PlaylistID:    req.PlaylistID,
+		UserID:        req.UserID,
+		Date:          date,
+		StartPosition: sql.NullInt32{Int32: playlistOperation.Positions[0], Valid: true},
+		Ranks:         ranks,
+	})
+	if err != nil {
+		slog.Error("[CreateMelonTop100] - Error saving the playlist build", "playlistID", req.PlaylistID, "error", err)
+	}
+	return nil
+}
+
+// GetCreateMelonTop100Job returns the result of the async CreateMelonTop100 request once it's done
+func (playlistServer *PlaylistServer) GetCreateMelonTop100Job(ctx context.Context, req *proto.GetCreateMelonTop100JobRequest) (*proto.GetCreateMelonTop100JobResponse, error) {
+	job, ok := playlistServer.melonTop100Jobs.get(req.JobID)
+	// Someone else's job is reported as not found so that its playlist isn't exposed
+	if !ok || job.UserID != req.UserID {
+		return nil, fmt.Errorf("job not found: %s", req.JobID)
+	}
+
+	response := &proto.GetCreateMelonTop100JobResponse{
+		JobID:  job.ID,
+		Status: job.Status,
+	}
+	if len(job.Results) > 0 {
+		response.Result = job.Results[0]
+	}
 	return response, nil
 }
 
@@ -174,12 +370,7 @@ func (playlistServer *PlaylistServer) GetMissedTracks(ctx context.Context, req *
 	// Convert database missed tracks to proto missed tracks
 	var protoMissedTracks []*proto.MissedTrack
 	for _, track := range missedTracks {
-		protoMissedTracks = append(protoMissedTracks, &proto.MissedTrack{
-			Rank:   track.Rank,
-			Title:  track.Title,
-			Artist: track.Artist,
-			Date:   track.Date.Format(time.RFC3339),
-		})
+		protoMissedTracks = append(protoMissedTracks, convertMissedTrackToProto(track))
 	}
 
 	return &proto.GetMissedTrackResponse{
@@ -188,38 +379,356 @@ func (playlistServer *PlaylistServer) GetMissedTracks(ctx context.Context, req *
 
 }
 
+// SuggestCandidates returns the top spotify candidates for each missed track of the date
+// so that the user can pick the correct track instead of typing the title and artist
+func (playlistServer *PlaylistServer) SuggestCandidates(ctx context.Context, req *proto.SuggestCandidatesRequest) (*proto.SuggestCandidatesResponse, error) {
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date format: %v", err)
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultCandidateLimit
+	}
+	if limit > maxCandidateLimit {
+		limit = maxCandidateLimit
+	}
+
+	missedTracks, err := playlistServer.DB.GetMissedTracksByDate(ctx, date)
+	if err != nil {
+		return nil, fmt.Errorf("error getting missed tracks: %v", err)
+	}
+
+	// Search candidates of every missed track concurrently while keeping the order of the missed tracks
+	results := make([]*proto.MissedTrackCandidates, len(missedTracks))
+	var wg sync.WaitGroup
+	for i, track := range missedTracks {
+		wg.Add(1)
+		go func(i int, track database.GetMissedTracksByDateRow) {
+			defer wg.Done()
+
+			candidates, err := spotify.SearchTrackCandidates(track.Title, track.Artist, limit, req.AccessToken)
+			if err != nil {
+				slog.Error("Error searching candidates", "title", track.Title, "artist", track.Artist, "error", err)
+			}
+
+			protoCandidates := make([]*proto.TrackCandidate, 0, len(candidates))
+			for _, candidate := range candidates {
+				protoCandidates = append(protoCandidates, &proto.TrackCandidate{
+					Uri:           candidate.URI,
+					Title:         candidate.Name,
+					Artists:       candidate.Artists,
+					Album:         candidate.Album,
+					AlbumImageUrl: candidate.AlbumImageURL,
+					PreviewUrl:    candidate.PreviewURL,
+					Score:         candidate.Score,
+				})
+			}
+
+			results[i] = &proto.MissedTrackCandidates{
+				MissedTrack: convertMissedTrackToProto(track),
+				Candidates:  protoCandidates,
+			}
+		}(i, track)
+	}
+	wg.Wait()
+
+	return &proto.SuggestCandidatesResponse{
+		MissedTracks: results,
+	}, nil
+}
+
 // ResolveMissedTracks resolves the missed tracks and adds them to resolved tracks DB
+// Returns the result of each track. If there are more than resolveJobThreshold tracks,
+// they are resolved in the background and the jobID is returned to check the results with GetResolveJob
 func (playlistServer *PlaylistServer) ResolveMissedTracks(ctx context.Context, req *proto.ResolveMissedTracksRequest) (*proto.ResolveMissedTracksResponse, error) {
 	resolvedTracks := req.ResolvedTracks
 	if len(resolvedTracks) == 0 || req.AccessToken == "" {
 		return nil, fmt.Errorf("no resolved tracks provided")
 	}
 
-	// 1. Check if the resolved track and artist from the frontend is correct by checking the spotify search
-	go func() {
-		for _, track := range resolvedTracks {
-
-			searchedTrack, err := spotify.SearchTrack(track.Title, track.Artist, req.AccessToken)
-			if err != nil || searchedTrack == nil {
-				fmt.Printf("Error searching resolved track for the track: %v. Error: %v", track, err)
-				continue
+	if len(resolvedTracks) > resolveJobThreshold {
+		jobID := playlistServer.resolveJobs.create(req.UserID, len(resolvedTracks))
+		go func() {
+			for _, track := range resolvedTracks {
+				playlistServer.resolveJobs.addResult(jobID, playlistServer.resolveMissedTrack(track, req.UserID, req.AccessToken))
 			}
+			playlistServer.resolveJobs.finish(jobID)
+			slog.Info("Resolved missed tracks", "jobID", jobID, "tracks", len(resolvedTracks))
+		}()
+
+		return &proto.ResolveMissedTracksResponse{
+			Status: fmt.Sprintf("Resolving %d missed tracks in the background", len(resolvedTracks)),
+			JobID:  jobID,
+		}, nil
+	}
 
-			// Resolved track found
-			if searchedTrack.URI != "" {
-				err := playlistServer.performDBTXForResolvedTrack(track, searchedTrack)
-				if err != nil {
-					fmt.Printf("Error performing DB transaction for the resolved track: %v. Error: %v", track, err)
-				}
-			}
+	results := make([]*proto.ResolveResult, 0, len(resolvedTracks))
+	resolvedCount := 0
+	for _, track := range resolvedTracks {
+		result := playlistServer.resolveMissedTrack(track, req.UserID, req.AccessToken)
+		if result.Resolved {
+			resolvedCount++
+		}
+		results = append(results, result)
+	}
+
+	return &proto.ResolveMissedTracksResponse{
+		Status:  fmt.Sprintf("Resolved %d of %d missed tracks", resolvedCount, len(resolvedTracks)),
+		Results: results,
+	}, nil
+}
+
+// GetResolveJob returns the status and the results so far of the background ResolveMissedTracks job
+func (playlistServer *PlaylistServer) GetResolveJob(ctx context.Context, req *proto.GetResolveJobRequest) (*proto.GetResolveJobResponse, error) {
+	job, ok := playlistServer.resolveJobs.get(req.JobID)
+	// Someone else's job is reported as not found so that its tracks aren't exposed
+	if !ok || job.UserID != req.UserID {
+		return nil, fmt.Errorf("job not found: %s", req.JobID)
+	}
+
+	return &proto.GetResolveJobResponse{
+		JobID:     job.ID,
+		Status:    job.Status,
+		Total:     int32(job.Total),
+		Completed: int32(len(job.Results)),
+		Results:   job.Results,
+	}, nil
+}
+
+// ListResolvedTracks returns the saved mappings of the missed tracks, newest first
+func (playlistServer *PlaylistServer) ListResolvedTracks(ctx context.Context, req *proto.ListResolvedTracksRequest) (*proto.ListResolvedTracksResponse, error) {
+	resolvedTracks, err := playlistServer.DB.ListResolvedTracks(ctx, req.ReportedOnly)
+	if err != nil {
+		slog.Error("Error listing resolved tracks", "error", err)
+		return nil, fmt.Errorf("error listing resolved tracks: %v", err)
+	}
+
+	protoResolvedTracks := make([]*proto.ResolvedMapping, 0, len(resolvedTracks))
+	for _, resolvedTrack := range resolvedTracks {
+		protoResolvedTracks = append(protoResolvedTracks, convertResolvedTrackToProto(resolvedTrack))
+	}
+
+	return &proto.ListResolvedTracksResponse{
+		ResolvedTracks: protoResolvedTracks,
+	}, nil
+}
+
+// UpdateResolvedTrack changes the spotify track of the wrong mapping and rewrites the tracks of the past dates
+// which were saved with the wrong spotify track
+func (playlistServer *PlaylistServer) UpdateResolvedTrack(ctx context.Context, req *proto.UpdateResolvedTrackRequest) (*proto.UpdateResolvedTrackResponse, error) {
+	if req.SpotifyURI == "" {
+		return nil, fmt.Errorf("spotifyURI is required")
+	}
+
+	searchedTrack, err := spotify.GetTrack(req.SpotifyURI, req.AccessToken)
+	if err != nil || searchedTrack == nil || searchedTrack.URI == "" {
+		slog.Error("Error getting the spotify track", "spotifyURI", req.SpotifyURI, "error", err)
+		return nil, fmt.Errorf("track not found on spotify: %v", err)
+	}
+
+	resolvedTrack, tracksUpdated, skippedDates, err := playlistServer.updateResolvedTrackTX(ctx, req.MissedTitle, req.MissedArtist, searchedTrack)
+	if err != nil {
+		return nil, err
+	}
 
+	protoSkippedDates := make([]string, 0, len(skippedDates))
+	for _, date := range skippedDates {
+		protoSkippedDates = append(protoSkippedDates, date.Format("2006-01-02"))
+	}
+
+	return &proto.UpdateResolvedTrackResponse{
+		ResolvedTrack: convertResolvedTrackToProto(resolvedTrack),
+		TracksUpdated: int32(tracksUpdated),
+		SkippedDates:  protoSkippedDates,
+	}, nil
+}
+
+// updateResolvedTrackTX changes the spotify track of the mapping and the tracks saved with the old spotify track in a transaction
+// returns the updated mapping, the number of tracks updated and the dates skipped because the new spotify track
+// was already saved on them. uri is part of the primary key of the tracks so those rows can't be rewritten
+func (playlistServer *PlaylistServer) updateResolvedTrackTX(ctx context.Context, missedTitle, missedArtist string, searchedTrack *spotify.Track) (database.ResolvedTrack, int64, []time.Time, error) {
+	tx, err := playlistServer.DBConn.Begin()
+	if err != nil {
+		slog.Error("Error starting transaction", "error", err)
+		return database.ResolvedTrack{}, 0, nil, err
+	}
+	defer tx.Rollback()
+
+	qtx := playlistServer.DB.WithTx(tx)
+
+	oldResolvedTrack, err := qtx.GetResolvedTrack(ctx, database.GetResolvedTrackParams{
+		MissedTitle:  missedTitle,
+		MissedArtist: missedArtist,
+	})
+	if err == sql.ErrNoRows {
+		return database.ResolvedTrack{}, 0, nil, fmt.Errorf("resolved track not found: %s - %s", missedTitle, missedArtist)
+	} else if err != nil {
+		slog.Error("Error getting resolved track", "missedTitle", missedTitle, "missedArtist", missedArtist, "error", err)
+		return database.ResolvedTrack{}, 0, nil, err
+	}
+
+	resolvedTrack, err := qtx.UpdateResolvedTrack(ctx, database.UpdateResolvedTrackParams{
+		MissedTitle:  missedTitle,
+		MissedArtist: missedArtist,
+		Title:        searchedTrack.Name,
+		Artist:       searchedTrack.Artist,
+		Uri:          searchedTrack.URI,
+		Album:        searchedTrack.Album,
+		DurationMs:   int32(searchedTrack.DurationMs),
+		Isrc:         searchedTrack.ISRC,
+		Date:         time.Now(),
+	})
+	if err != nil {
+		slog.Error("Error updating resolved track", "missedTitle", missedTitle, "missedArtist", missedArtist, "error", err)
+		return database.ResolvedTrack{}, 0, nil, err
+	}
+
+	skippedDates, err := qtx.ListResolvedTrackConflictDates(ctx, database.ListResolvedTrackConflictDatesParams{
+		MelonTitle:  missedTitle,
+		MelonArtist: missedArtist,
+		OldUri:      oldResolvedTrack.Uri,
+		NewUri:      searchedTrack.URI,
+	})
+	if err != nil {
+		slog.Error("Error getting the dates already saved with the new track", "missedTitle", missedTitle, "missedArtist", missedArtist, "error", err)
+		return database.ResolvedTrack{}, 0, nil, err
+	}
+
+	// The exact track was picked so it's same as resolving with the spotify URI
+	tracksUpdated, err := qtx.UpdateTracksForResolvedTrack(ctx, database.UpdateTracksForResolvedTrackParams{
+		Title:           searchedTrack.Name,
+		Artist:          searchedTrack.Artist,
+		NewUri:          searchedTrack.URI,
+		Album:           searchedTrack.Album,
+		DurationMs:      int32(searchedTrack.DurationMs),
+		Isrc:            searchedTrack.ISRC,
+		MatchMethod:     matchMethodManualURI,
+		MatchConfidence: 1,
+		MelonTitle:      missedTitle,
+		MelonArtist:     missedArtist,
+		OldUri:          oldResolvedTrack.Uri,
+	})
+	if err != nil {
+		slog.Error("Error updating tracks of the resolved track", "missedTitle", missedTitle, "missedArtist", missedArtist, "error", err)
+		return database.ResolvedTrack{}, 0, nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return database.ResolvedTrack{}, 0, nil, err
+	}
+
+	if len(skippedDates) > 0 {
+		slog.Warn("Skipped the dates already saved with the new track", "missedTitle", missedTitle, "missedArtist", missedArtist, "newURI", searchedTrack.URI, "skippedDates", skippedDates)
+	}
+	slog.Info("Updated resolved track", "missedTitle", missedTitle, "missedArtist", missedArtist, "oldURI", oldResolvedTrack.Uri, "newURI", searchedTrack.URI, "tracksUpdated", tracksUpdated)
+	return resolvedTrack, tracksUpdated, skippedDates, nil
+}
+
+// DeleteResolvedTrack removes the wrong mapping. The tracks saved with the mapping are removed from the tracks DB
+// and added back to the missed tracks so that they can be resolved again
+func (playlistServer *PlaylistServer) DeleteResolvedTrack(ctx context.Context, req *proto.DeleteResolvedTrackRequest) (*proto.DeleteResolvedTrackResponse, error) {
+	tx, err := playlistServer.DBConn.Begin()
+	if err != nil {
+		slog.Error("Error starting transaction", "error", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	qtx := playlistServer.DB.WithTx(tx)
+
+	resolvedTrack, err := qtx.DeleteResolvedTrack(ctx, database.DeleteResolvedTrackParams{
+		MissedTitle:  req.MissedTitle,
+		MissedArtist: req.MissedArtist,
+	})
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("resolved track not found: %s - %s", req.MissedTitle, req.MissedArtist)
+	} else if err != nil {
+		slog.Error("Error deleting resolved track", "missedTitle", req.MissedTitle, "missedArtist", req.MissedArtist, "error", err)
+		return nil, err
+	}
+
+	removedTracks, err := qtx.DeleteTracksForResolvedTrack(ctx, database.DeleteTracksForResolvedTrackParams{
+		MelonTitle:  req.MissedTitle,
+		MelonArtist: req.MissedArtist,
+		Uri:         resolvedTrack.Uri,
+	})
+	if err != nil {
+		slog.Error("Error deleting tracks of the resolved track", "missedTitle", req.MissedTitle, "missedArtist", req.MissedArtist, "error", err)
+		return nil, err
+	}
+
+	if err = addTracksToMissedTracks(ctx, qtx, req.MissedTitle, req.MissedArtist, removedTracks); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Deleted resolved track", "missedTitle", req.MissedTitle, "missedArtist", req.MissedArtist, "uri", resolvedTrack.Uri, "tracksRemoved", len(removedTracks))
+
+	return &proto.DeleteResolvedTrackResponse{
+		TracksRemoved: int32(len(removedTracks)),
+	}, nil
+}
+
+// addTracksToMissedTracks adds the removed chart tracks of the melon title and artist back to the missed tracks
+// so that they can be resolved again
+func addTracksToMissedTracks(ctx context.Context, qtx *database.Queries, missedTitle, missedArtist string, removedTracks []database.Track) error {
+	if len(removedTracks) == 0 {
+		return nil
+	}
+
+	missedTrack, err := qtx.UpsertMissedTrack(ctx, database.UpsertMissedTrackParams{
+		Title:  missedTitle,
+		Artist: missedArtist,
+		Album:  removedTracks[0].MelonAlbum,
+	})
+	if err != nil {
+		slog.Error("Error saving missed track to DB", "missedTitle", missedTitle, "missedArtist", missedArtist, "error", err)
+		return err
+	}
+
+	for _, track := range removedTracks {
+		_, err = qtx.CreateMissedTrackAppearance(ctx, database.CreateMissedTrackAppearanceParams{
+			MissedTrackID: missedTrack.ID,
+			Rank:          track.Rank,
+			Date:          track.Date,
+		})
+		if err != nil {
+			slog.Error("Error saving missed track appearance to DB", "missedTitle", missedTitle, "date", track.Date, "error", err)
+			return err
 		}
+	}
+	return nil
+}
 
-		fmt.Println("Resolved missed tracks")
-	}()
+// ReportWrongMatch flags every saved chart track (and the resolved mapping if there is one) with the spotify URI
+// as a wrong match so that it can be fixed with UpdateResolvedTrack or DeleteResolvedTrack
+func (playlistServer *PlaylistServer) ReportWrongMatch(ctx context.Context, req *proto.ReportWrongMatchRequest) (*proto.ReportWrongMatchResponse, error) {
+	if req.Uri == "" {
+		return nil, fmt.Errorf("uri is required")
+	}
 
-	return &proto.ResolveMissedTracksResponse{
-		Status: "Resolving missed tracks asynchronously",
+	tracksReported, err := playlistServer.DB.ReportTrackWrongMatch(ctx, req.Uri)
+	if err != nil {
+		slog.Error("Error reporting the track", "uri", req.Uri, "error", err)
+		return nil, err
+	}
+
+	resolvedReported, err := playlistServer.DB.ReportResolvedTrackWrongMatch(ctx, req.Uri)
+	if err != nil {
+		slog.Error("Error reporting the resolved track", "uri", req.Uri, "error", err)
+		return nil, err
+	}
+
+	slog.Info("Reported wrong match", "uri", req.Uri, "tracksReported", tracksReported, "resolvedReported", resolvedReported)
+
+	return &proto.ReportWrongMatchResponse{
+		TracksReported:        int32(tracksReported),
+		ResolvedTrackReported: resolvedReported > 0,
 	}, nil
 }
 
@@ -254,6 +763,76 @@ func (PlaylistServer *PlaylistServer) GetUserPlaylistTracks(ctx context.Context,
 	}, nil
 }
 
+// GetPlaylist returns the playlist with every track, so the client doesn't page through the tracks endpoint itself
+func (playlistServer *PlaylistServer) GetPlaylist(ctx context.Context, req *proto.GetPlaylistRequest) (*proto.GetPlaylistResponse, error) {
+	if req.PlaylistID == "" {
+		return nil, fmt.Errorf("playlistID is required")
+	}
+
+	playlist, err := spotify.GetPlaylist(req.PlaylistID, req.AccessToken)
+	if err != nil {
+		slog.Error("Error getting the playlist", "playlistID", req.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error getting the playlist: %v", err)
+	}
+
+	protoPlaylist := &proto.PlaylistDetail{
+		SpotifyPlaylistID: playlist.ID,
+		Name:              playlist.Name,
+		Description:       playlist.Description,
+		ExternalUrl:       playlist.ExternalURLs.Spotify,
+		OwnerID:           playlist.Owner.ID,
+		OwnerName:         playlist.Owner.DisplayName,
+		Followers:         int32(playlist.Followers.Total),
+		Public:            playlist.Public,
+		Collaborative:     playlist.Collaborative,
+		SnapshotID:        playlist.SnapshotID,
+		TotalTracks:       int32(playlist.Tracks.Total),
+		Tracks:            make([]*proto.PlaylistDetailTrack, 0, len(playlist.Tracks.Items)),
+	}
+	if len(playlist.Images) > 0 {
+		protoPlaylist.ImageUrl = playlist.Images[0].Url
+	}
+
+	for i, item := range playlist.Tracks.Items {
+		protoPlaylist.Tracks = append(protoPlaylist.Tracks, convertPlaylistDetailItemToProto(i, item))
+	}
+
+	slog.Info("Fetched playlist", "playlistID", req.PlaylistID, "tracks", len(protoPlaylist.Tracks))
+
+	return &proto.GetPlaylistResponse{
+		Playlist: protoPlaylist,
+	}, nil
+}
+
+func convertPlaylistDetailItemToProto(position int, item spotify.PlaylistDetailItem) *proto.PlaylistDetailTrack {
+	protoTrack := &proto.PlaylistDetailTrack{
+		Position: int32(position),
+		AddedAt:  item.AddedAt,
+		IsLocal:  item.IsLocal,
+	}
+	if item.Track == nil {
+		return protoTrack
+	}
+
+	artists := make([]string, 0, len(item.Track.Artists))
+	for _, artist := range item.Track.Artists {
+		artists = append(artists, artist.Name)
+	}
+
+	protoTrack.Title = item.Track.Name
+	protoTrack.Artist = strings.Join(artists, ", ")
+	protoTrack.Album = item.Track.Album.Name
+	protoTrack.Uri = item.Track.URI
+	protoTrack.DurationMs = int32(item.Track.DurationMs)
+	protoTrack.Popularity = int32(item.Track.Popularity)
+	// is_playable is missing for local files
+	protoTrack.Available = item.Track.IsPlayable == nil || *item.Track.IsPlayable
+	if len(item.Track.Album.Images) > 0 {
+		protoTrack.AlbumImageUrl = item.Track.Album.Images[0].Url
+	}
+	return protoTrack
+}
+
 // ------------------ Helper Functions ------------------
 
 func (PlaylistServer *PlaylistServer) searchTracksAndSaveToDB(date time.Time, accessToken string) {
@@ -265,7 +844,11 @@ func (PlaylistServer *PlaylistServer) searchTracksAndSaveToDB(date time.Time, ac
 
 	// Make the db insert non-blocking
 	// TODO - Find a way to do bulk insert
-	go PlaylistServer.saveTrackToDB(songChan)
+	saved := make(chan struct{})
+	go func() {
+		PlaylistServer.saveTrackToDB(songChan)
+		close(saved)
+	}()
 
 	// Search tracks (this gets executed before the db insert above)
 	for i, song := range songs {
@@ -275,16 +858,29 @@ func (PlaylistServer *PlaylistServer) searchTracksAndSaveToDB(date time.Time, ac
 
 	wg.Wait()
 	close(songChan)
+	<-saved
+
+	// Update the subscribed playlists once every track of the date is saved
+	PlaylistServer.applySubscriptions(chartMelonTop100, date)
 }
 
 func (playlistServer *PlaylistServer) saveTrackToDB(songChan <-chan SongDB) {
 	for songDB := range songChan {
 		_, err := playlistServer.DB.CreateTrack(context.Background(), database.CreateTrackParams{
-			Rank:   songDB.Rank,
-			Title:  songDB.Title,
-			Artist: songDB.Artist,
-			Uri:    songDB.URI,
-			Date:   songDB.Date,
+			Rank:            songDB.Rank,
+			Title:           songDB.Title,
+			Artist:          songDB.Artist,
+			Uri:             songDB.URI,
+			Date:            songDB.Date,
+			MelonTitle:      songDB.MelonTitle,
+			MelonArtist:     songDB.MelonArtist,
+			MelonAlbum:      songDB.MelonAlbum,
+			Album:           songDB.Album,
+			DurationMs:      songDB.DurationMs,
+			Isrc:            songDB.ISRC,
+			MatchMethod:     songDB.MatchMethod,
+			MatchConfidence: float32(songDB.MatchConfidence),
+			ReviewStatus:    songDB.ReviewStatus,
 		})
 
 		if err != nil {
@@ -305,12 +901,39 @@ func (playlistServer *PlaylistServer) processSong(song mscraper.Song, index int,
 
 	// If track successfully found from Spotify, add it to the songChan
 	if track != nil && track.URI != "" {
+		confidence := spotify.MatchConfidence(song.Title, song.Artist, track)
+		reviewStatus := reviewStatusApproved
+
+		if confidence < spotify.MatchReviewThreshold {
+			// Use the track picked by the user if the song was already reviewed or resolved
+			resolvedTrack, err := playlistServer.DB.GetResolvedTrack(context.Background(), database.GetResolvedTrackParams{
+				MissedTitle:  song.Title,
+				MissedArtist: song.Artist,
+			})
+			if err == nil {
+				songChan <- resolvedTrackToSongDB(resolvedTrack, song, index, date)
+				return
+			}
+
+			slog.Info("Low confidence match is pending review", "song", song, "uri", track.URI, "confidence", confidence)
+			reviewStatus = reviewStatusPending
+		}
+
 		songChan <- SongDB{
-			Rank:   int32(index + 1),
-			Title:  track.Name,
-			Artist: track.Artist,
-			URI:    track.URI,
-			Date:   date,
+			Rank:            int32(index + 1),
+			Title:           track.Name,
+			Artist:          track.Artist,
+			Album:           track.Album,
+			URI:             track.URI,
+			DurationMs:      int32(track.DurationMs),
+			ISRC:            track.ISRC,
+			MelonTitle:      song.Title,
+			MelonArtist:     song.Artist,
+			MelonAlbum:      song.Album,
+			MatchMethod:     matchMethodSearch,
+			MatchConfidence: confidence,
+			ReviewStatus:    reviewStatus,
+			Date:            date,
 		}
 	}
 }
@@ -323,85 +946,340 @@ func (playlistServer *PlaylistServer) handleTrackSearchError(song mscraper.Song,
 
 	if err != nil {
 		slog.Info("Adding it to the missed track DB", "song", song, "error", err)
-		_, err := playlistServer.DB.CreateMissedTrack(context.Background(), database.CreateMissedTrackParams{
-			Rank:   int32(index + 1),
+		missedTrack, err := playlistServer.DB.UpsertMissedTrack(context.Background(), database.UpsertMissedTrackParams{
 			Title:  song.Title,
 			Artist: song.Artist,
-			Date:   date,
+			Album:  song.Album,
 		})
 		if err != nil {
 			slog.Error("Error saving missed track to DB", "error", err)
+			return
+		}
+
+		// Same track can be missed on multiple days so save the rank of each day separately
+		_, err = playlistServer.DB.CreateMissedTrackAppearance(context.Background(), database.CreateMissedTrackAppearanceParams{
+			MissedTrackID: missedTrack.ID,
+			Rank:          int32(index + 1),
+			Date:          date,
+		})
+		if err != nil {
+			slog.Error("Error saving missed track appearance to DB", "error", err)
 		}
 		return
 	}
 
 	// Resolved track found. Add it to the songChan
-	songChan <- SongDB{
-		Rank:   int32(index + 1),
-		Title:  resolvedTrack.Title,
-		Artist: resolvedTrack.Artist,
-		URI:    resolvedTrack.Uri,
-		Date:   date,
+	songChan <- resolvedTrackToSongDB(resolvedTrack, song, index, date)
+}
+
+func resolvedTrackToSongDB(resolvedTrack database.ResolvedTrack, song mscraper.Song, index int, date time.Time) SongDB {
+	return SongDB{
+		Rank:            int32(index + 1),
+		Title:           resolvedTrack.Title,
+		Artist:          resolvedTrack.Artist,
+		Album:           resolvedTrack.Album,
+		URI:             resolvedTrack.Uri,
+		DurationMs:      resolvedTrack.DurationMs,
+		ISRC:            resolvedTrack.Isrc,
+		MelonTitle:      song.Title,
+		MelonArtist:     song.Artist,
+		MelonAlbum:      song.Album,
+		MatchMethod:     matchMethodResolved,
+		MatchConfidence: 1, // confirmed by the user when it was resolved
+		ReviewStatus:    reviewStatusApproved,
+		Date:            date,
 	}
 }
 
+// resolveMissedTrack checks if the resolved track from the frontend is correct by looking up the spotify URI
+// or by checking the spotify search with the title and artist, and saves it to the DB
+func (playlistServer *PlaylistServer) resolveMissedTrack(track *proto.ResolvedTrack, userID string, accessToken string) *proto.ResolveResult {
+	result := &proto.ResolveResult{
+		MissedTitle:  track.MissedTitle,
+		MissedArtist: track.MissedArtist,
+	}
+
+	var searchedTrack *spotify.Track
+	var err error
+	matchMethod := matchMethodManual
+	if track.SpotifyURI != "" {
+		searchedTrack, err = spotify.GetTrack(track.SpotifyURI, accessToken)
+		matchMethod = matchMethodManualURI
+	} else {
+		searchedTrack, err = spotify.SearchTrack(track.Title, track.Artist, accessToken)
+	}
+	if err != nil || searchedTrack == nil || searchedTrack.URI == "" {
+		slog.Error("Error searching resolved track", "track", track, "error", err)
+		result.Error = fmt.Sprintf("track not found on spotify: %v", err)
+		return result
+	}
+	result.ResolvedURI = searchedTrack.URI
+
+	missedRemoved, backfilled, err := playlistServer.performDBTXForResolvedTrack(track, searchedTrack, matchMethod)
+	if err != nil {
+		slog.Error("Error performing DB transaction for the resolved track", "track", track, "error", err)
+		result.Error = fmt.Sprintf("error saving the resolved track: %v", err)
+		return result
+	}
+
+	result.Resolved = true
+	result.MissedRemoved = missedRemoved
+	// Any user can resolve, so only the caller's own playlists are updated
+	result.PlaylistsUpdated = int32(playlistServer.addResolvedTrackToPlaylists(searchedTrack.URI, backfilled, userID, accessToken))
+	return result
+}
+
 // performDBTXForResolvedTrack performs a database transaction for the resolved track
 // @param resolvedTrack: the resolved track from the frontend
-// @param searchedTrack: the track found from the spotify search
-func (playlistServer *PlaylistServer) performDBTXForResolvedTrack(resolvedTrack *proto.ResolvedTrack, searchedTrack *spotify.Track) error {
+// @param searchedTrack: the track found from the spotify search or the spotify URI lookup
+// @param matchMethod: how the searchedTrack was found (matchMethodManual or matchMethodManualURI)
+// returns whether the missed track was removed from the missed tracks DB and the dates (with the rank) the track was newly added to the tracks DB
+func (playlistServer *PlaylistServer) performDBTXForResolvedTrack(resolvedTrack *proto.ResolvedTrack, searchedTrack *spotify.Track, matchMethod string) (bool, []database.MissedTrackAppearance, error) {
 	slog.Info("Performing DB transaction for the resolved track", "resolvedTrack", resolvedTrack, "searchedTrack", searchedTrack)
 
 	tx, err := playlistServer.DBConn.Begin()
 	if err != nil {
 		slog.Error("Error starting transaction", "error", err)
-		return err
+		return false, nil, err
 	}
 
 	defer tx.Rollback()
 
 	qtx := playlistServer.DB.WithTx(tx)
 
-	date, err := time.Parse("2006-01-02", resolvedTrack.Date)
-	if err != nil {
-		slog.Error("Error parsing date", "date", resolvedTrack.Date, "error", err)
-		return err
-	}
 	_, err = qtx.CreateResolvedTrack(context.Background(), database.CreateResolvedTrackParams{
 		MissedTitle:  resolvedTrack.MissedTitle,
 		MissedArtist: resolvedTrack.MissedArtist,
 		Title:        searchedTrack.Name,
 		Artist:       searchedTrack.Artist,
+		Album:        searchedTrack.Album,
 		Uri:          searchedTrack.URI,
+		DurationMs:   int32(searchedTrack.DurationMs),
+		Isrc:         searchedTrack.ISRC,
 		Date:         time.Now(),
 	})
 	if err != nil {
 		slog.Error("Error saving resolved track to DB", "resolvedTrack", resolvedTrack, "error", err)
-		return err
+		return false, nil, err
 	}
 
-	_, err = qtx.RemoveMissedTrack(context.Background(), database.RemoveMissedTrackParams{
+	// Missed track might already be removed (ex. resolved by someone else), which is fine
+	missedRemoved := true
+	missedTrack, err := qtx.GetMissedTracks(context.Background(), database.GetMissedTracksParams{
 		Title:  resolvedTrack.MissedTitle,
 		Artist: resolvedTrack.MissedArtist,
 	})
+	if err == sql.ErrNoRows {
+		missedRemoved = false
+	} else if err != nil {
+		slog.Error("Error getting missed track from DB", "resolvedTrack", resolvedTrack, "error", err)
+		return false, nil, err
+	}
+
+	var appearances []database.MissedTrackAppearance
+	if missedRemoved {
+		appearances, err = qtx.GetMissedTrackAppearances(context.Background(), missedTrack.ID)
+		if err != nil {
+			slog.Error("Error getting missed track appearances from DB", "resolvedTrack", resolvedTrack, "error", err)
+			return false, nil, err
+		}
+
+		// Appearances are removed together with the missed track
+		_, err = qtx.RemoveMissedTrack(context.Background(), database.RemoveMissedTrackParams{
+			Title:  resolvedTrack.MissedTitle,
+			Artist: resolvedTrack.MissedArtist,
+		})
+		if err != nil {
+			slog.Error("Error removing missed track from DB", "resolvedTrack", resolvedTrack, "error", err)
+			return false, nil, err
+		}
+	}
+
+	// Nothing recorded for the missed track so only save it for the date from the frontend
+	// (imported mappings don't have a date and are only used for the next charts)
+	if len(appearances) == 0 && resolvedTrack.Date != "" {
+		date, err := time.Parse("2006-01-02", resolvedTrack.Date)
+		if err != nil {
+			slog.Error("Error parsing date", "date", resolvedTrack.Date, "error", err)
+			return false, nil, err
+		}
+		appearances = append(appearances, database.MissedTrackAppearance{
+			Rank: resolvedTrack.Rank,
+			Date: date,
+		})
+	}
+
+	// The exact track was picked by the user so no need to score it
+	matchConfidence := 1.0
+	if matchMethod != matchMethodManualURI {
+		matchConfidence = spotify.MatchConfidence(resolvedTrack.Title, resolvedTrack.Artist, searchedTrack)
+	}
+
+	// save the resolved track in the tracks DB for every day it was missed
+	var backfilled []database.MissedTrackAppearance
+	for _, appearance := range appearances {
+		inserted, err := qtx.BackfillTrack(context.Background(), database.BackfillTrackParams{
+			Rank:            appearance.Rank,
+			Title:           searchedTrack.Name,
+			Artist:          searchedTrack.Artist,
+			Uri:             searchedTrack.URI,
+			Date:            appearance.Date,
+			MelonTitle:      resolvedTrack.MissedTitle,
+			MelonArtist:     resolvedTrack.MissedArtist,
+			MelonAlbum:      missedTrack.Album,
+			Album:           searchedTrack.Album,
+			DurationMs:      int32(searchedTrack.DurationMs),
+			Isrc:            searchedTrack.ISRC,
+			MatchMethod:     matchMethod,
+			MatchConfidence: float32(matchConfidence),
+		})
+		if err != nil {
+			slog.Error("Error saving resolved track to DB", "resolvedTrack", resolvedTrack, "date", appearance.Date, "error", err)
+			return false, nil, err
+		}
+
+		// Same track is already in the chart of the date
+		if inserted > 0 {
+			backfilled = append(backfilled, appearance)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, nil, err
+	}
+	return missedRemoved, backfilled, nil
+}
+
+// addResolvedTrackToPlaylists inserts the resolved track at its chart position to the playlists
+// which were already created with CreateMelonTop100 for the dates the track was missed
+// returns the number of playlists the track was added to.
+// With ownerID only the owner's playlists are updated with ownerAccessToken. Without it every user's playlist is updated
+// with the token from the auth server, which is only for the admin paths
+func (playlistServer *PlaylistServer) addResolvedTrackToPlaylists(uri string, appearances []database.MissedTrackAppearance, ownerID string, ownerAccessToken string) int {
+	// Same user can have playlists of multiple dates so only get the token once
+	accessTokens := make(map[string]string)
+	if ownerID != "" {
+		accessTokens[ownerID] = ownerAccessToken
+	}
+	updated := 0
+
+	for _, appearance := range appearances {
+		builds, err := playlistServer.DB.GetPlaylistBuildsByDate(context.Background(), appearance.Date)
+		if err != nil {
+			slog.Error("Error getting playlist builds", "date", appearance.Date, "error", err)
+			continue
+		}
+
+		for _, build := range builds {
+			if ownerID != "" && build.UserID != ownerID {
+				continue
+			}
+			// Builds saved before the positions were remembered can't tell where the track goes
+			if !build.StartPosition.Valid || build.Ranks == nil {
+				continue
+			}
+
+			accessToken, ok := accessTokens[build.UserID]
+			if !ok {
+				accessToken, err = getUserAccessToken(build.UserID)
+				if err != nil {
+					slog.Error("Error getting access token of the playlist owner", "userID", build.UserID, "error", err)
+					continue
+				}
+				accessTokens[build.UserID] = accessToken
+			}
+
+			if playlistServer.addResolvedTrackToPlaylist(build, uri, appearance.Rank, accessToken) {
+				updated++
+			}
+		}
+	}
+
+	return updated
+}
+
+// addResolvedTrackToPlaylist inserts the resolved track right after the tracks of the build ranked above it.
+// Returns false when the track wasn't inserted, ex. it's already in the playlist from the build of another date
+func (playlistServer *PlaylistServer) addResolvedTrackToPlaylist(build database.PlaylistBuild, uri string, rank int32, accessToken string) bool {
+	current, err := spotify.GetPlaylistSnapshot(build.PlaylistID, accessToken)
 	if err != nil {
-		slog.Error("Error removing missed track from DB", "resolvedTrack", resolvedTrack, "error", err)
-		return err
+		slog.Error("Error getting the playlist to add the resolved track", "playlistID", build.PlaylistID, "error", err)
+		return false
+	}
+	if slices.Contains(current.URIs, uri) {
+		return false
+	}
+
+	position := int(build.StartPosition.Int32)
+	for _, buildRank := range build.Ranks {
+		if buildRank < rank {
+			position++
+		}
+	}
+	if position > len(current.URIs) {
+		slog.Info("Playlist is shorter than the position of the resolved track", "playlistID", build.PlaylistID, "position", position, "tracks", len(current.URIs))
+		return false
+	}
+
+	ctx := context.Background()
+	if _, err := playlistServer.savePlaylistSnapshot(ctx, build.UserID, build.PlaylistID, operationResolvedTrack, current); err != nil {
+		return false
 	}
 
-	// save the resolved track in the tracks DB
-	_, err = qtx.CreateTrack(context.Background(), database.CreateTrackParams{
-		Rank:   resolvedTrack.Rank,
-		Title:  searchedTrack.Name,
-		Artist: searchedTrack.Artist,
-		Uri:    searchedTrack.URI,
-		Date:   date,
+	addResponse, err := spotify.AddTrackToPlaylistAt(build.PlaylistID, []string{uri}, position, accessToken)
+	if err != nil {
+		slog.Error("Error adding the resolved track to the playlist", "playlistID", build.PlaylistID, "uri", uri, "error", err)
+		return false
+	}
+	playlistServer.recordDeliveredTracks(build.UserID, build.PlaylistID, []string{uri})
+	playlistServer.recordPlaylistOperation(ctx, build.UserID, build.PlaylistID, operationResolvedTrack, []string{uri}, position, addResponse.SnapshotID)
+
+	// The track is now one of the build's and the tracks of the later builds of the playlist moved down by one
+	err = playlistServer.DB.AddPlaylistBuildRank(ctx, database.AddPlaylistBuildRankParams{
+		PlaylistID: build.PlaylistID,
+		Date:       build.Date,
+		Rank:       rank,
 	})
 	if err != nil {
-		slog.Error("Error saving resolved track to DB", "resolvedTrack", resolvedTrack, "error", err)
-		return err
+		slog.Error("Error adding the rank of the resolved track to the playlist build", "playlistID", build.PlaylistID, "error", err)
+	}
+	err = playlistServer.DB.ShiftPlaylistBuilds(ctx, database.ShiftPlaylistBuildsParams{
+		PlaylistID:    build.PlaylistID,
+		Date:          build.Date,
+		StartPosition: sql.NullInt32{Int32: int32(position), Valid: true},
+	})
+	if err != nil {
+		slog.Error("Error shifting the later playlist builds", "playlistID", build.PlaylistID, "error", err)
 	}
+	return true
+}
 
-	return tx.Commit()
+// convertMissedTrackToProto converts the missed track of a date to the proto missed track
+func convertMissedTrackToProto(track database.GetMissedTracksByDateRow) *proto.MissedTrack {
+	return &proto.MissedTrack{
+		Rank:            track.Rank,
+		Title:           track.Title,
+		Artist:          track.Artist,
+		Date:            track.Date.Format(time.RFC3339),
+		DaysMissed:      track.DaysMissed,
+		FirstMissedDate: track.FirstMissedDate.Format(time.RFC3339),
+	}
+}
+
+// convertResolvedTrackToProto converts the saved mapping of the missed track to the proto resolved mapping
+func convertResolvedTrackToProto(resolvedTrack database.ResolvedTrack) *proto.ResolvedMapping {
+	return &proto.ResolvedMapping{
+		MissedTitle:   resolvedTrack.MissedTitle,
+		MissedArtist:  resolvedTrack.MissedArtist,
+		Title:         resolvedTrack.Title,
+		Artist:        resolvedTrack.Artist,
+		Uri:           resolvedTrack.Uri,
+		Album:         resolvedTrack.Album,
+		DurationMs:    resolvedTrack.DurationMs,
+		Isrc:          resolvedTrack.Isrc,
+		Date:          resolvedTrack.Date.Format(time.RFC3339),
+		ReportedWrong: resolvedTrack.ReportedWrong,
+	}
 }
 
 // getKST returns the current date in KST timezone
@@ -5,29 +5,127 @@
 package database
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 )
 
+type DeliveredTrack struct {
+	UserID      string
+	Uri         string
+	PlaylistID  string
+	DeliveredAt time.Time
+}
+
+type ReleaseRadar struct {
+	UserID          string
+	PlaylistID      string
+	IncludeFollowed bool
+	LastRunAt       sql.NullTime
+	CreatedAt       time.Time
+}
+
+type FavoriteArtist struct {
+	UserID          string
+	SpotifyArtistID string
+	Name            string
+	Source          string
+	CreatedAt       time.Time
+}
+
 type MissedTrack struct {
-	Rank   int32
+	ID     int32
 	Title  string
 	Artist string
-	Date   time.Time
+	Album  string
+}
+
+type MissedTrackAppearance struct {
+	MissedTrackID int32
+	Rank          int32
+	Date          time.Time
+}
+
+type PlaylistBuild struct {
+	PlaylistID    string
+	UserID        string
+	Date          time.Time
+	CreatedAt     time.Time
+	StartPosition sql.NullInt32
+	Ranks         []int32
+}
+
+type PlaylistOperation struct {
+	ID                int32
+	UserID            string
+	PlaylistID        string
+	Operation         string
+	Uris              []string
+	Positions         []int32
+	SpotifySnapshotID string
+	UndoneAt          sql.NullTime
+	CreatedAt         time.Time
+}
+
+type PlaylistSnapshot struct {
+	ID                int32
+	UserID            string
+	PlaylistID        string
+	SpotifySnapshotID string
+	Uris              []string
+	Operation         string
+	CreatedAt         time.Time
+}
+
+type PlaylistSubscription struct {
+	ID              int32
+	UserID          string
+	PlaylistID      string
+	Chart           string
+	Policy          string
+	WindowDays      int32
+	LastAppliedDate sql.NullTime
+	CreatedAt       time.Time
 }
 
 type ResolvedTrack struct {
-	MissedTitle  string
-	MissedArtist string
-	Title        string
-	Artist       string
-	Uri          string
-	Date         time.Time
+	MissedTitle   string
+	MissedArtist  string
+	Title         string
+	Artist        string
+	Uri           string
+	Date          time.Time
+	Album         string
+	DurationMs    int32
+	Isrc          string
+	ReportedWrong bool
+}
+
+type SmartPlaylist struct {
+	ID              int32
+	UserID          string
+	Name            string
+	PlaylistID      string
+	Rules           json.RawMessage
+	LastEvaluatedAt sql.NullTime
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 type Track struct {
-	Rank   int32
-	Title  string
-	Artist string
-	Uri    string
-	Date   time.Time
+	Rank            int32
+	Title           string
+	Artist          string
+	Uri             string
+	Date            time.Time
+	MelonTitle      string
+	MelonArtist     string
+	MelonAlbum      string
+	Album           string
+	DurationMs      int32
+	Isrc            string
+	MatchMethod     string
+	MatchConfidence float32
+	ReportedWrong   bool
+	ReviewStatus    string
 }
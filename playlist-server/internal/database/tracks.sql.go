@@ -7,43 +7,129 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
-const createMissedTrack = `-- name: CreateMissedTrack :one
-INSERT INTO missed_tracks (rank, title, artist, date)
-VALUES ($1, $2, $3, $4)
-	RETURNING rank, title, artist, date
+const approvePendingTracks = `-- name: ApprovePendingTracks :many
+UPDATE tracks SET review_status = 'approved'
+WHERE melon_title = $1 AND melon_artist = $2 AND uri = $3 AND review_status = 'pending'
+RETURNING rank, title, artist, uri, date, melon_title, melon_artist, melon_album, album, duration_ms, isrc, match_method, match_confidence, reported_wrong, review_status
 `
 
-type CreateMissedTrackParams struct {
-	Rank   int32
-	Title  string
-	Artist string
-	Date   time.Time
+type ApprovePendingTracksParams struct {
+	MelonTitle  string
+	MelonArtist string
+	Uri         string
+}
+
+func (q *Queries) ApprovePendingTracks(ctx context.Context, arg ApprovePendingTracksParams) ([]Track, error) {
+	rows, err := q.db.QueryContext(ctx, approvePendingTracks, arg.MelonTitle, arg.MelonArtist, arg.Uri)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Track
+	for rows.Next() {
+		var i Track
+		if err := rows.Scan(
+			&i.Rank,
+			&i.Title,
+			&i.Artist,
+			&i.Uri,
+			&i.Date,
+			&i.MelonTitle,
+			&i.MelonArtist,
+			&i.MelonAlbum,
+			&i.Album,
+			&i.DurationMs,
+			&i.Isrc,
+			&i.MatchMethod,
+			&i.MatchConfidence,
+			&i.ReportedWrong,
+			&i.ReviewStatus,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) CreateMissedTrack(ctx context.Context, arg CreateMissedTrackParams) (MissedTrack, error) {
-	row := q.db.QueryRowContext(ctx, createMissedTrack,
+const backfillTrack = `-- name: BackfillTrack :execrows
+INSERT INTO tracks (rank, title, artist, uri, date, melon_title, melon_artist, melon_album, album, duration_ms, isrc, match_method, match_confidence)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+ON CONFLICT (uri, date) DO NOTHING
+`
+
+type BackfillTrackParams struct {
+	Rank            int32
+	Title           string
+	Artist          string
+	Uri             string
+	Date            time.Time
+	MelonTitle      string
+	MelonArtist     string
+	MelonAlbum      string
+	Album           string
+	DurationMs      int32
+	Isrc            string
+	MatchMethod     string
+	MatchConfidence float32
+}
+
+func (q *Queries) BackfillTrack(ctx context.Context, arg BackfillTrackParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, backfillTrack,
 		arg.Rank,
 		arg.Title,
 		arg.Artist,
+		arg.Uri,
 		arg.Date,
+		arg.MelonTitle,
+		arg.MelonArtist,
+		arg.MelonAlbum,
+		arg.Album,
+		arg.DurationMs,
+		arg.Isrc,
+		arg.MatchMethod,
+		arg.MatchConfidence,
 	)
-	var i MissedTrack
-	err := row.Scan(
-		&i.Rank,
-		&i.Title,
-		&i.Artist,
-		&i.Date,
-	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createMissedTrackAppearance = `-- name: CreateMissedTrackAppearance :one
+INSERT INTO missed_track_appearances (missed_track_id, rank, date)
+VALUES ($1, $2, $3)
+ON CONFLICT (missed_track_id, date) DO UPDATE SET rank = EXCLUDED.rank
+	RETURNING missed_track_id, rank, date
+`
+
+type CreateMissedTrackAppearanceParams struct {
+	MissedTrackID int32
+	Rank          int32
+	Date          time.Time
+}
+
+func (q *Queries) CreateMissedTrackAppearance(ctx context.Context, arg CreateMissedTrackAppearanceParams) (MissedTrackAppearance, error) {
+	row := q.db.QueryRowContext(ctx, createMissedTrackAppearance, arg.MissedTrackID, arg.Rank, arg.Date)
+	var i MissedTrackAppearance
+	err := row.Scan(&i.MissedTrackID, &i.Rank, &i.Date)
 	return i, err
 }
 
 const createResolvedTrack = `-- name: CreateResolvedTrack :one
-INSERT INTO resolved_tracks (missed_title, missed_artist, title, artist, uri, date)
-VALUES ($1, $2, $3, $4, $5, $6)
-	RETURNING missed_title, missed_artist, title, artist, uri, date
+INSERT INTO resolved_tracks (missed_title, missed_artist, title, artist, uri, date, album, duration_ms, isrc)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	RETURNING missed_title, missed_artist, title, artist, uri, date, album, duration_ms, isrc, reported_wrong
 `
 
 type CreateResolvedTrackParams struct {
@@ -53,6 +139,9 @@ type CreateResolvedTrackParams struct {
 	Artist       string
 	Uri          string
 	Date         time.Time
+	Album        string
+	DurationMs   int32
+	Isrc         string
 }
 
 func (q *Queries) CreateResolvedTrack(ctx context.Context, arg CreateResolvedTrackParams) (ResolvedTrack, error) {
@@ -63,6 +152,9 @@ func (q *Queries) CreateResolvedTrack(ctx context.Context, arg CreateResolvedTra
 		arg.Artist,
 		arg.Uri,
 		arg.Date,
+		arg.Album,
+		arg.DurationMs,
+		arg.Isrc,
 	)
 	var i ResolvedTrack
 	err := row.Scan(
@@ -72,22 +164,35 @@ func (q *Queries) CreateResolvedTrack(ctx context.Context, arg CreateResolvedTra
 		&i.Artist,
 		&i.Uri,
 		&i.Date,
+		&i.Album,
+		&i.DurationMs,
+		&i.Isrc,
+		&i.ReportedWrong,
 	)
 	return i, err
 }
 
 const createTrack = `-- name: CreateTrack :one
-INSERT INTO tracks (rank, title, artist, uri, date)
-VALUES ($1, $2, $3, $4, $5)
-	RETURNING rank, title, artist, uri, date
+INSERT INTO tracks (rank, title, artist, uri, date, melon_title, melon_artist, melon_album, album, duration_ms, isrc, match_method, match_confidence, review_status)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	RETURNING rank, title, artist, uri, date, melon_title, melon_artist, melon_album, album, duration_ms, isrc, match_method, match_confidence, reported_wrong, review_status
 `
 
 type CreateTrackParams struct {
-	Rank   int32
-	Title  string
-	Artist string
-	Uri    string
-	Date   time.Time
+	Rank            int32
+	Title           string
+	Artist          string
+	Uri             string
+	Date            time.Time
+	MelonTitle      string
+	MelonArtist     string
+	MelonAlbum      string
+	Album           string
+	DurationMs      int32
+	Isrc            string
+	MatchMethod     string
+	MatchConfidence float32
+	ReviewStatus    string
 }
 
 func (q *Queries) CreateTrack(ctx context.Context, arg CreateTrackParams) (Track, error) {
@@ -97,6 +202,15 @@ func (q *Queries) CreateTrack(ctx context.Context, arg CreateTrackParams) (Track
 		arg.Artist,
 		arg.Uri,
 		arg.Date,
+		arg.MelonTitle,
+		arg.MelonArtist,
+		arg.MelonAlbum,
+		arg.Album,
+		arg.DurationMs,
+		arg.Isrc,
+		arg.MatchMethod,
+		arg.MatchConfidence,
+		arg.ReviewStatus,
 	)
 	var i Track
 	err := row.Scan(
@@ -105,12 +219,177 @@ func (q *Queries) CreateTrack(ctx context.Context, arg CreateTrackParams) (Track
 		&i.Artist,
 		&i.Uri,
 		&i.Date,
+		&i.MelonTitle,
+		&i.MelonArtist,
+		&i.MelonAlbum,
+		&i.Album,
+		&i.DurationMs,
+		&i.Isrc,
+		&i.MatchMethod,
+		&i.MatchConfidence,
+		&i.ReportedWrong,
+		&i.ReviewStatus,
+	)
+	return i, err
+}
+
+const deletePendingTracks = `-- name: DeletePendingTracks :many
+DELETE FROM tracks WHERE melon_title = $1 AND melon_artist = $2 AND uri = $3 AND review_status = 'pending'
+RETURNING rank, title, artist, uri, date, melon_title, melon_artist, melon_album, album, duration_ms, isrc, match_method, match_confidence, reported_wrong, review_status
+`
+
+type DeletePendingTracksParams struct {
+	MelonTitle  string
+	MelonArtist string
+	Uri         string
+}
+
+func (q *Queries) DeletePendingTracks(ctx context.Context, arg DeletePendingTracksParams) ([]Track, error) {
+	rows, err := q.db.QueryContext(ctx, deletePendingTracks, arg.MelonTitle, arg.MelonArtist, arg.Uri)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Track
+	for rows.Next() {
+		var i Track
+		if err := rows.Scan(
+			&i.Rank,
+			&i.Title,
+			&i.Artist,
+			&i.Uri,
+			&i.Date,
+			&i.MelonTitle,
+			&i.MelonArtist,
+			&i.MelonAlbum,
+			&i.Album,
+			&i.DurationMs,
+			&i.Isrc,
+			&i.MatchMethod,
+			&i.MatchConfidence,
+			&i.ReportedWrong,
+			&i.ReviewStatus,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteResolvedTrack = `-- name: DeleteResolvedTrack :one
+DELETE FROM resolved_tracks WHERE missed_title = $1 AND missed_artist = $2
+RETURNING missed_title, missed_artist, title, artist, uri, date, album, duration_ms, isrc, reported_wrong
+`
+
+type DeleteResolvedTrackParams struct {
+	MissedTitle  string
+	MissedArtist string
+}
+
+func (q *Queries) DeleteResolvedTrack(ctx context.Context, arg DeleteResolvedTrackParams) (ResolvedTrack, error) {
+	row := q.db.QueryRowContext(ctx, deleteResolvedTrack, arg.MissedTitle, arg.MissedArtist)
+	var i ResolvedTrack
+	err := row.Scan(
+		&i.MissedTitle,
+		&i.MissedArtist,
+		&i.Title,
+		&i.Artist,
+		&i.Uri,
+		&i.Date,
+		&i.Album,
+		&i.DurationMs,
+		&i.Isrc,
+		&i.ReportedWrong,
 	)
 	return i, err
 }
 
+const deleteTracksForResolvedTrack = `-- name: DeleteTracksForResolvedTrack :many
+DELETE FROM tracks WHERE melon_title = $1 AND melon_artist = $2 AND uri = $3
+RETURNING rank, title, artist, uri, date, melon_title, melon_artist, melon_album, album, duration_ms, isrc, match_method, match_confidence, reported_wrong, review_status
+`
+
+type DeleteTracksForResolvedTrackParams struct {
+	MelonTitle  string
+	MelonArtist string
+	Uri         string
+}
+
+func (q *Queries) DeleteTracksForResolvedTrack(ctx context.Context, arg DeleteTracksForResolvedTrackParams) ([]Track, error) {
+	rows, err := q.db.QueryContext(ctx, deleteTracksForResolvedTrack, arg.MelonTitle, arg.MelonArtist, arg.Uri)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Track
+	for rows.Next() {
+		var i Track
+		if err := rows.Scan(
+			&i.Rank,
+			&i.Title,
+			&i.Artist,
+			&i.Uri,
+			&i.Date,
+			&i.MelonTitle,
+			&i.MelonArtist,
+			&i.MelonAlbum,
+			&i.Album,
+			&i.DurationMs,
+			&i.Isrc,
+			&i.MatchMethod,
+			&i.MatchConfidence,
+			&i.ReportedWrong,
+			&i.ReviewStatus,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMissedTrackAppearances = `-- name: GetMissedTrackAppearances :many
+SELECT missed_track_id, rank, date FROM missed_track_appearances WHERE missed_track_id = $1 ORDER BY date
+`
+
+func (q *Queries) GetMissedTrackAppearances(ctx context.Context, missedTrackID int32) ([]MissedTrackAppearance, error) {
+	rows, err := q.db.QueryContext(ctx, getMissedTrackAppearances, missedTrackID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MissedTrackAppearance
+	for rows.Next() {
+		var i MissedTrackAppearance
+		if err := rows.Scan(&i.MissedTrackID, &i.Rank, &i.Date); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMissedTracks = `-- name: GetMissedTracks :one
-SELECT rank, title, artist, date FROM missed_tracks WHERE title = $1 AND artist = $2
+SELECT id, title, artist, album FROM missed_tracks WHERE title = $1 AND artist = $2
 `
 
 type GetMissedTracksParams struct {
@@ -122,32 +401,54 @@ func (q *Queries) GetMissedTracks(ctx context.Context, arg GetMissedTracksParams
 	row := q.db.QueryRowContext(ctx, getMissedTracks, arg.Title, arg.Artist)
 	var i MissedTrack
 	err := row.Scan(
-		&i.Rank,
+		&i.ID,
 		&i.Title,
 		&i.Artist,
-		&i.Date,
+		&i.Album,
 	)
 	return i, err
 }
 
 const getMissedTracksByDate = `-- name: GetMissedTracksByDate :many
-SELECT rank, title, artist, date FROM missed_tracks WHERE date = $1
+SELECT missed_tracks.id, missed_tracks.title, missed_tracks.artist, missed_tracks.album,
+	missed_track_appearances.rank, missed_track_appearances.date,
+	(SELECT COUNT(*) FROM missed_track_appearances a WHERE a.missed_track_id = missed_tracks.id)::INTEGER AS days_missed,
+	(SELECT MIN(a.date) FROM missed_track_appearances a WHERE a.missed_track_id = missed_tracks.id)::DATE AS first_missed_date
+FROM missed_tracks
+JOIN missed_track_appearances ON missed_track_appearances.missed_track_id = missed_tracks.id
+WHERE missed_track_appearances.date = $1
+ORDER BY missed_track_appearances.rank
 `
 
-func (q *Queries) GetMissedTracksByDate(ctx context.Context, date time.Time) ([]MissedTrack, error) {
+type GetMissedTracksByDateRow struct {
+	ID              int32
+	Title           string
+	Artist          string
+	Album           string
+	Rank            int32
+	Date            time.Time
+	DaysMissed      int32
+	FirstMissedDate time.Time
+}
+
+func (q *Queries) GetMissedTracksByDate(ctx context.Context, date time.Time) ([]GetMissedTracksByDateRow, error) {
 	rows, err := q.db.QueryContext(ctx, getMissedTracksByDate, date)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []MissedTrack
+	var items []GetMissedTracksByDateRow
 	for rows.Next() {
-		var i MissedTrack
+		var i GetMissedTracksByDateRow
 		if err := rows.Scan(
-			&i.Rank,
+			&i.ID,
 			&i.Title,
 			&i.Artist,
+			&i.Album,
+			&i.Rank,
 			&i.Date,
+			&i.DaysMissed,
+			&i.FirstMissedDate,
 		); err != nil {
 			return nil, err
 		}
@@ -163,7 +464,7 @@ func (q *Queries) GetMissedTracksByDate(ctx context.Context, date time.Time) ([]
 }
 
 const getResolvedTrack = `-- name: GetResolvedTrack :one
-SELECT missed_title, missed_artist, title, artist, uri, date FROM resolved_tracks WHERE missed_title = $1 AND missed_artist = $2
+SELECT missed_title, missed_artist, title, artist, uri, date, album, duration_ms, isrc, reported_wrong FROM resolved_tracks WHERE missed_title = $1 AND missed_artist = $2
 `
 
 type GetResolvedTrackParams struct {
@@ -181,12 +482,58 @@ func (q *Queries) GetResolvedTrack(ctx context.Context, arg GetResolvedTrackPara
 		&i.Artist,
 		&i.Uri,
 		&i.Date,
+		&i.Album,
+		&i.DurationMs,
+		&i.Isrc,
+		&i.ReportedWrong,
 	)
 	return i, err
 }
 
+const getTrackURIsBetweenDates = `-- name: GetTrackURIsBetweenDates :many
+SELECT uri, MIN(rank)::INTEGER AS best_rank, MAX(date)::DATE AS last_date
+FROM tracks
+WHERE date BETWEEN $1 AND $2 AND review_status = 'approved'
+GROUP BY uri
+ORDER BY last_date DESC, best_rank
+`
+
+type GetTrackURIsBetweenDatesParams struct {
+	FromDate time.Time
+	ToDate   time.Time
+}
+
+type GetTrackURIsBetweenDatesRow struct {
+	Uri      string
+	BestRank int32
+	LastDate time.Time
+}
+
+func (q *Queries) GetTrackURIsBetweenDates(ctx context.Context, arg GetTrackURIsBetweenDatesParams) ([]GetTrackURIsBetweenDatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTrackURIsBetweenDates, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTrackURIsBetweenDatesRow
+	for rows.Next() {
+		var i GetTrackURIsBetweenDatesRow
+		if err := rows.Scan(&i.Uri, &i.BestRank, &i.LastDate); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTracksByDate = `-- name: GetTracksByDate :many
-SELECT rank, title, artist, uri, date FROM tracks WHERE date = $1
+SELECT rank, title, artist, uri, date, melon_title, melon_artist, melon_album, album, duration_ms, isrc, match_method, match_confidence, reported_wrong, review_status FROM tracks WHERE date = $1 ORDER BY rank
 `
 
 func (q *Queries) GetTracksByDate(ctx context.Context, date time.Time) ([]Track, error) {
@@ -204,6 +551,146 @@ func (q *Queries) GetTracksByDate(ctx context.Context, date time.Time) ([]Track,
 			&i.Artist,
 			&i.Uri,
 			&i.Date,
+			&i.MelonTitle,
+			&i.MelonArtist,
+			&i.MelonAlbum,
+			&i.Album,
+			&i.DurationMs,
+			&i.Isrc,
+			&i.MatchMethod,
+			&i.MatchConfidence,
+			&i.ReportedWrong,
+			&i.ReviewStatus,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingTracks = `-- name: ListPendingTracks :many
+SELECT rank, title, artist, uri, date, melon_title, melon_artist, melon_album, album, duration_ms, isrc, match_method, match_confidence, reported_wrong, review_status FROM tracks
+WHERE review_status = 'pending' AND ($1::DATE IS NULL OR date = $1)
+ORDER BY date DESC, rank
+`
+
+func (q *Queries) ListPendingTracks(ctx context.Context, date sql.NullTime) ([]Track, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingTracks, date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Track
+	for rows.Next() {
+		var i Track
+		if err := rows.Scan(
+			&i.Rank,
+			&i.Title,
+			&i.Artist,
+			&i.Uri,
+			&i.Date,
+			&i.MelonTitle,
+			&i.MelonArtist,
+			&i.MelonAlbum,
+			&i.Album,
+			&i.DurationMs,
+			&i.Isrc,
+			&i.MatchMethod,
+			&i.MatchConfidence,
+			&i.ReportedWrong,
+			&i.ReviewStatus,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResolvedTrackConflictDates = `-- name: ListResolvedTrackConflictDates :many
+SELECT date FROM tracks
+WHERE melon_title = $1 AND melon_artist = $2 AND uri = $3
+  AND EXISTS (
+    SELECT 1 FROM tracks existing
+    WHERE existing.uri = $4 AND existing.date = tracks.date AND existing.uri <> tracks.uri
+  )
+ORDER BY date
+`
+
+type ListResolvedTrackConflictDatesParams struct {
+	MelonTitle  string
+	MelonArtist string
+	OldUri      string
+	NewUri      string
+}
+
+func (q *Queries) ListResolvedTrackConflictDates(ctx context.Context, arg ListResolvedTrackConflictDatesParams) ([]time.Time, error) {
+	rows, err := q.db.QueryContext(ctx, listResolvedTrackConflictDates,
+		arg.MelonTitle,
+		arg.MelonArtist,
+		arg.OldUri,
+		arg.NewUri,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []time.Time
+	for rows.Next() {
+		var date time.Time
+		if err := rows.Scan(&date); err != nil {
+			return nil, err
+		}
+		items = append(items, date)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResolvedTracks = `-- name: ListResolvedTracks :many
+SELECT missed_title, missed_artist, title, artist, uri, date, album, duration_ms, isrc, reported_wrong FROM resolved_tracks
+WHERE NOT $1::BOOLEAN OR reported_wrong
+ORDER BY date DESC
+`
+
+func (q *Queries) ListResolvedTracks(ctx context.Context, reportedOnly bool) ([]ResolvedTrack, error) {
+	rows, err := q.db.QueryContext(ctx, listResolvedTracks, reportedOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResolvedTrack
+	for rows.Next() {
+		var i ResolvedTrack
+		if err := rows.Scan(
+			&i.MissedTitle,
+			&i.MissedArtist,
+			&i.Title,
+			&i.Artist,
+			&i.Uri,
+			&i.Date,
+			&i.Album,
+			&i.DurationMs,
+			&i.Isrc,
+			&i.ReportedWrong,
 		); err != nil {
 			return nil, err
 		}
@@ -220,7 +707,7 @@ func (q *Queries) GetTracksByDate(ctx context.Context, date time.Time) ([]Track,
 
 const removeMissedTrack = `-- name: RemoveMissedTrack :one
 DELETE FROM missed_tracks WHERE title = $1 AND artist = $2
-RETURNING rank, title, artist, date
+RETURNING id, title, artist, album
 `
 
 type RemoveMissedTrackParams struct {
@@ -232,10 +719,215 @@ func (q *Queries) RemoveMissedTrack(ctx context.Context, arg RemoveMissedTrackPa
 	row := q.db.QueryRowContext(ctx, removeMissedTrack, arg.Title, arg.Artist)
 	var i MissedTrack
 	err := row.Scan(
-		&i.Rank,
+		&i.ID,
 		&i.Title,
 		&i.Artist,
+		&i.Album,
+	)
+	return i, err
+}
+
+const replacePendingTracks = `-- name: ReplacePendingTracks :execrows
+UPDATE tracks
+SET title = $1,
+    artist = $2,
+    uri = $3,
+    album = $4,
+    duration_ms = $5,
+    isrc = $6,
+    match_method = $7,
+    match_confidence = $8,
+    reported_wrong = false,
+    review_status = 'approved'
+WHERE melon_title = $9 AND melon_artist = $10 AND uri = $11 AND review_status = 'pending'
+`
+
+type ReplacePendingTracksParams struct {
+	Title           string
+	Artist          string
+	NewUri          string
+	Album           string
+	DurationMs      int32
+	Isrc            string
+	MatchMethod     string
+	MatchConfidence float32
+	MelonTitle      string
+	MelonArtist     string
+	OldUri          string
+}
+
+func (q *Queries) ReplacePendingTracks(ctx context.Context, arg ReplacePendingTracksParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, replacePendingTracks,
+		arg.Title,
+		arg.Artist,
+		arg.NewUri,
+		arg.Album,
+		arg.DurationMs,
+		arg.Isrc,
+		arg.MatchMethod,
+		arg.MatchConfidence,
+		arg.MelonTitle,
+		arg.MelonArtist,
+		arg.OldUri,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const reportResolvedTrackWrongMatch = `-- name: ReportResolvedTrackWrongMatch :execrows
+UPDATE resolved_tracks SET reported_wrong = true WHERE uri = $1
+`
+
+func (q *Queries) ReportResolvedTrackWrongMatch(ctx context.Context, uri string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, reportResolvedTrackWrongMatch, uri)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const reportTrackWrongMatch = `-- name: ReportTrackWrongMatch :execrows
+UPDATE tracks SET reported_wrong = true WHERE uri = $1
+`
+
+func (q *Queries) ReportTrackWrongMatch(ctx context.Context, uri string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, reportTrackWrongMatch, uri)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateResolvedTrack = `-- name: UpdateResolvedTrack :one
+UPDATE resolved_tracks
+SET title = $3,
+    artist = $4,
+    uri = $5,
+    album = $6,
+    duration_ms = $7,
+    isrc = $8,
+    date = $9,
+    reported_wrong = false
+WHERE missed_title = $1 AND missed_artist = $2
+RETURNING missed_title, missed_artist, title, artist, uri, date, album, duration_ms, isrc, reported_wrong
+`
+
+type UpdateResolvedTrackParams struct {
+	MissedTitle  string
+	MissedArtist string
+	Title        string
+	Artist       string
+	Uri          string
+	Album        string
+	DurationMs   int32
+	Isrc         string
+	Date         time.Time
+}
+
+func (q *Queries) UpdateResolvedTrack(ctx context.Context, arg UpdateResolvedTrackParams) (ResolvedTrack, error) {
+	row := q.db.QueryRowContext(ctx, updateResolvedTrack,
+		arg.MissedTitle,
+		arg.MissedArtist,
+		arg.Title,
+		arg.Artist,
+		arg.Uri,
+		arg.Album,
+		arg.DurationMs,
+		arg.Isrc,
+		arg.Date,
+	)
+	var i ResolvedTrack
+	err := row.Scan(
+		&i.MissedTitle,
+		&i.MissedArtist,
+		&i.Title,
+		&i.Artist,
+		&i.Uri,
 		&i.Date,
+		&i.Album,
+		&i.DurationMs,
+		&i.Isrc,
+		&i.ReportedWrong,
+	)
+	return i, err
+}
+
+const updateTracksForResolvedTrack = `-- name: UpdateTracksForResolvedTrack :execrows
+UPDATE tracks
+SET title = $1,
+    artist = $2,
+    uri = $3,
+    album = $4,
+    duration_ms = $5,
+    isrc = $6,
+    match_method = $7,
+    match_confidence = $8,
+    reported_wrong = false,
+    review_status = 'approved'
+WHERE melon_title = $9 AND melon_artist = $10 AND uri = $11
+  AND NOT EXISTS (
+    SELECT 1 FROM tracks existing
+    WHERE existing.uri = $3 AND existing.date = tracks.date AND existing.uri <> tracks.uri
+  )
+`
+
+type UpdateTracksForResolvedTrackParams struct {
+	Title           string
+	Artist          string
+	NewUri          string
+	Album           string
+	DurationMs      int32
+	Isrc            string
+	MatchMethod     string
+	MatchConfidence float32
+	MelonTitle      string
+	MelonArtist     string
+	OldUri          string
+}
+
+func (q *Queries) UpdateTracksForResolvedTrack(ctx context.Context, arg UpdateTracksForResolvedTrackParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateTracksForResolvedTrack,
+		arg.Title,
+		arg.Artist,
+		arg.NewUri,
+		arg.Album,
+		arg.DurationMs,
+		arg.Isrc,
+		arg.MatchMethod,
+		arg.MatchConfidence,
+		arg.MelonTitle,
+		arg.MelonArtist,
+		arg.OldUri,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const upsertMissedTrack = `-- name: UpsertMissedTrack :one
+INSERT INTO missed_tracks (title, artist, album)
+VALUES ($1, $2, $3)
+ON CONFLICT (title, artist) DO UPDATE SET album = EXCLUDED.album
+	RETURNING id, title, artist, album
+`
+
+type UpsertMissedTrackParams struct {
+	Title  string
+	Artist string
+	Album  string
+}
+
+func (q *Queries) UpsertMissedTrack(ctx context.Context, arg UpsertMissedTrackParams) (MissedTrack, error) {
+	row := q.db.QueryRowContext(ctx, upsertMissedTrack, arg.Title, arg.Artist, arg.Album)
+	var i MissedTrack
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Artist,
+		&i.Album,
 	)
 	return i, err
 }
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+const (
+	jobStatusRunning = "running"
+	jobStatusDone    = "done"
+)
+
+// How long a finished job is kept in memory before it's removed
+const jobRetention = time.Hour
+
+// job is a long running request processed in the background. Results are added as each item finishes
+type job[T any] struct {
+	ID         string
+	UserID     string // only the user who started the job can check it
+	Status     string
+	Total      int
+	Results    []T
+	FinishedAt time.Time
+}
+
+// jobStore keeps the jobs in memory so that the caller can check the status of the job with its ID later
+type jobStore[T any] struct {
+	mu   sync.Mutex
+	jobs map[string]*job[T]
+}
+
+func newJobStore[T any]() *jobStore[T] {
+	return &jobStore[T]{
+		jobs: make(map[string]*job[T]),
+	}
+}
+
+// create registers a new running job of the user with the total number of items and returns the job ID
+func (store *jobStore[T]) create(userID string, total int) string {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	// Remove old finished jobs so the store doesn't grow forever
+	for id, j := range store.jobs {
+		if j.Status == jobStatusDone && time.Since(j.FinishedAt) > jobRetention {
+			delete(store.jobs, id)
+		}
+	}
+
+	id := newJobID()
+	store.jobs[id] = &job[T]{
+		ID:     id,
+		UserID: userID,
+		Status: jobStatusRunning,
+		Total:  total,
+	}
+	return id
+}
+
+// addResult appends the result of a finished item to the job
+func (store *jobStore[T]) addResult(id string, result T) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if j, ok := store.jobs[id]; ok {
+		j.Results = append(j.Results, result)
+	}
+}
+
+// finish marks the job as done
+func (store *jobStore[T]) finish(id string) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if j, ok := store.jobs[id]; ok {
+		j.Status = jobStatusDone
+		j.FinishedAt = time.Now()
+	}
+}
+
+// get returns a copy of the job so it can be read while the job is still running
+func (store *jobStore[T]) get(id string) (job[T], bool) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	j, ok := store.jobs[id]
+	if !ok {
+		return job[T]{}, false
+	}
+
+	copied := *j
+	copied.Results = append([]T(nil), j.Results...)
+	return copied, true
+}
+
+func newJobID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
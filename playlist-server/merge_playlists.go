@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Modes of MergePlaylists
+const (
+	mergeModeUnion        = "union"        // tracks of any playlist
+	mergeModeIntersection = "intersection" // tracks in every playlist
+)
+
+// Keys to find the duplicate tracks
+const (
+	dedupeByURI  = "uri"
+	dedupeByISRC = "isrc" // same recording on a different album or single. Falls back to the URI without the ISRC
+)
+
+const mergedPlaylistDescription = "Merged playlist by melongo"
+
+// userPlaylistTrack is a track of the playlist at its position
+type userPlaylistTrack struct {
+	position int
+	uri      string
+	isrc     string
+	title    string
+	artist   string
+}
+
+// key returns the key of the track to find its duplicates
+func (track userPlaylistTrack) key(dedupeBy string) string {
+	if dedupeBy == dedupeByISRC && track.isrc != "" {
+		return "isrc:" + strings.ToUpper(track.isrc)
+	}
+	return track.uri
+}
+
+// getUserPlaylistTracks returns every track of the playlist by following the pages of GetUserPlaylistTracks.
+// Unavailable tracks and local files are skipped since they can't be added or removed by URI. Positions still count them
+func getUserPlaylistTracks(playlistID string, accessToken string) ([]userPlaylistTrack, error) {
+	var tracks []userPlaylistTrack
+
+	position := 0
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s/tracks?limit=100", playlistID)
+	for address != "" {
+		playlistTracks, err := spotify.GetUserPlaylistTracks(accessToken, address)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range playlistTracks.Items {
+			track := item.Track
+			if track.URI != "" && !track.IsLocal {
+				artists := make([]string, 0, len(track.Artists))
+				for _, artist := range track.Artists {
+					artists = append(artists, artist.Name)
+				}
+				tracks = append(tracks, userPlaylistTrack{
+					position: position,
+					uri:      track.URI,
+					isrc:     track.ExternalIDs.ISRC,
+					title:    track.Name,
+					artist:   strings.Join(artists, ", "),
+				})
+			}
+			position++
+		}
+		address = playlistTracks.Next
+	}
+
+	return tracks, nil
+}
+
+func validateDedupeBy(dedupeBy string) (string, error) {
+	switch dedupeBy {
+	case "":
+		return dedupeByURI, nil
+	case dedupeByURI, dedupeByISRC:
+		return dedupeBy, nil
+	default:
+		return "", fmt.Errorf("invalid dedupeBy: %s (expected %s or %s)", dedupeBy, dedupeByURI, dedupeByISRC)
+	}
+}
+
+// MergePlaylists writes the union or the intersection of the playlists without the duplicates to a new playlist or
+// to the target playlist. Appending to the target skips the tracks which are already in it
+func (playlistServer *PlaylistServer) MergePlaylists(ctx context.Context, req *proto.MergePlaylistsRequest) (*proto.MergePlaylistsResponse, error) {
+	if len(req.PlaylistIDs) < 2 {
+		return nil, fmt.Errorf("at least two playlists are required")
+	}
+
+	mode := req.Mode
+	if mode == "" {
+		mode = mergeModeUnion
+	}
+	if mode != mergeModeUnion && mode != mergeModeIntersection {
+		return nil, fmt.Errorf("invalid mode: %s (expected %s or %s)", mode, mergeModeUnion, mergeModeIntersection)
+	}
+	dedupeBy, err := validateDedupeBy(req.DedupeBy)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &proto.MergePlaylistsResponse{PlaylistID: req.TargetPlaylistID}
+
+	playlistTracks := make(map[string][]userPlaylistTrack)
+	// number of the playlists each track is in
+	playlistCounts := make(map[string]int)
+	for _, playlistID := range req.PlaylistIDs {
+		if _, ok := playlistTracks[playlistID]; ok {
+			return nil, fmt.Errorf("playlist %s is given more than once", playlistID)
+		}
+
+		tracks, err := getUserPlaylistTracks(playlistID, req.AccessToken)
+		if err != nil {
+			slog.Error("Error getting the tracks of the playlist to merge", "playlistID", playlistID, "error", err)
+			return nil, fmt.Errorf("error getting the tracks of the playlist %s: %v", playlistID, err)
+		}
+		playlistTracks[playlistID] = tracks
+		response.Total += int32(len(tracks))
+
+		inPlaylist := make(map[string]bool)
+		for _, track := range tracks {
+			key := track.key(dedupeBy)
+			if !inPlaylist[key] {
+				inPlaylist[key] = true
+				playlistCounts[key]++
+			}
+		}
+	}
+
+	// Tracks already in the target aren't appended again
+	skip := make(map[string]bool)
+	if req.TargetPlaylistID != "" && !req.Replace {
+		targetTracks, ok := playlistTracks[req.TargetPlaylistID]
+		if !ok {
+			targetTracks, err = getUserPlaylistTracks(req.TargetPlaylistID, req.AccessToken)
+			if err != nil {
+				slog.Error("Error getting the tracks of the target playlist", "playlistID", req.TargetPlaylistID, "error", err)
+				return nil, fmt.Errorf("error getting the tracks of the target playlist: %v", err)
+			}
+		}
+		for _, track := range targetTracks {
+			skip[track.key(dedupeBy)] = true
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, playlistID := range req.PlaylistIDs {
+		for _, track := range playlistTracks[playlistID] {
+			key := track.key(dedupeBy)
+			if mode == mergeModeIntersection && playlistCounts[key] < len(req.PlaylistIDs) {
+				continue
+			}
+			if seen[key] {
+				response.DuplicatesRemoved++
+				continue
+			}
+			seen[key] = true
+			if !skip[key] {
+				response.Uris = append(response.Uris, track.uri)
+			}
+		}
+	}
+
+	if req.DryRun {
+		response.Status = fmt.Sprintf("Merged %d tracks (%d duplicates removed)", len(response.Uris), response.DuplicatesRemoved)
+		return response, nil
+	}
+
+	if response.PlaylistID == "" {
+		name := req.TargetName
+		if name == "" {
+			name = fmt.Sprintf("Merged playlist %s", getKST().Format("2006-01-02"))
+		}
+		newPlaylist, err := spotify.CreateNewPlaylist(name, mergedPlaylistDescription, false, req.UserID, req.AccessToken)
+		if err != nil {
+			slog.Error("Error creating the merged playlist", "userID", req.UserID, "name", name, "error", err)
+			return nil, err
+		}
+		response.PlaylistID = newPlaylist.SpotifyPlaylistID
+		response.ExternalUrl = newPlaylist.URI
+	}
+
+	if req.Replace && req.TargetPlaylistID != "" {
+		response.SnapshotID, err = playlistServer.replaceUserPlaylistTracks(ctx, req.UserID, response.PlaylistID, operationMergePlaylists, response.Uris, req.AccessToken)
+	} else {
+		var playlistOperation database.PlaylistOperation
+		playlistOperation, err = playlistServer.addTracksToUserPlaylist(ctx, req.UserID, response.PlaylistID, operationMergePlaylists, response.Uris, req.AccessToken)
+		response.SnapshotID = playlistOperation.SpotifySnapshotID
+		response.OperationID = playlistOperation.ID
+	}
+	if err != nil {
+		slog.Error("Error writing the merged playlist", "playlistID", response.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error writing the merged playlist: %v", err)
+	}
+	response.Added = int32(len(response.Uris))
+
+	slog.Info("Merged playlists", "userID", req.UserID, "playlists", len(req.PlaylistIDs), "mode", mode, "dedupeBy", dedupeBy, "playlistID", response.PlaylistID, "added", response.Added, "duplicatesRemoved", response.DuplicatesRemoved)
+
+	response.Status = fmt.Sprintf("Wrote %d tracks to the playlist (%d duplicates removed)", response.Added, response.DuplicatesRemoved)
+	return response, nil
+}
+
+// DedupePlaylist removes every duplicate of the playlist in place and keeps the first occurrence of each track.
+// The playlist is snapshotted first so that the removed tracks can be restored
+func (playlistServer *PlaylistServer) DedupePlaylist(ctx context.Context, req *proto.DedupePlaylistRequest) (*proto.DedupePlaylistResponse, error) {
+	if req.PlaylistID == "" {
+		return nil, fmt.Errorf("playlistID is required")
+	}
+	dedupeBy, err := validateDedupeBy(req.DedupeBy)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks, err := getUserPlaylistTracks(req.PlaylistID, req.AccessToken)
+	if err != nil {
+		slog.Error("Error getting the tracks of the playlist to dedupe", "playlistID", req.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error getting the tracks of the playlist: %v", err)
+	}
+
+	response := &proto.DedupePlaylistResponse{}
+	keptPositions := make(map[string]int)
+	var duplicates []userPlaylistTrack
+	for _, track := range tracks {
+		key := track.key(dedupeBy)
+		keptPosition, ok := keptPositions[key]
+		if !ok {
+			keptPositions[key] = track.position
+			continue
+		}
+		duplicates = append(duplicates, track)
+		response.Duplicates = append(response.Duplicates, &proto.DuplicateTrack{
+			Uri:          track.uri,
+			Title:        track.title,
+			Artist:       track.artist,
+			Position:     int32(track.position),
+			KeptPosition: int32(keptPosition),
+		})
+	}
+
+	if req.DryRun || len(duplicates) == 0 {
+		response.Status = fmt.Sprintf("Found %d duplicates", len(duplicates))
+		return response, nil
+	}
+
+	backup, err := playlistServer.snapshotPlaylist(ctx, req.UserID, req.PlaylistID, operationDedupePlaylist, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Positions are removed at the snapshot so the playlist must not have changed since the tracks were read
+	for _, track := range duplicates {
+		if track.position >= len(backup.Uris) || backup.Uris[track.position] != track.uri {
+			slog.Info("Playlist changed while finding the duplicates", "playlistID", req.PlaylistID, "position", track.position)
+			return nil, status.Errorf(codes.FailedPrecondition, "playlist changed while finding the duplicates. Try again")
+		}
+	}
+
+	removeTracks := make([]spotify.RemoveTrack, 0, len(duplicates))
+	for _, track := range duplicates {
+		removeTracks = append(removeTracks, spotify.RemoveTrack{URI: track.uri, Positions: []int{track.position}})
+	}
+
+	removed, err := spotify.RemovePlaylistTracksAt(req.PlaylistID, removeTracks, backup.SpotifySnapshotID, req.AccessToken)
+	if err != nil {
+		slog.Error("Error removing the duplicates", "playlistID", req.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error removing the duplicates: %v", err)
+	}
+
+	slog.Info("Deduped playlist", "playlistID", req.PlaylistID, "dedupeBy", dedupeBy, "removed", len(duplicates))
+
+	response.Removed = int32(len(duplicates))
+	response.BackupSnapshotID = backup.ID
+	response.SpotifySnapshotID = removed.SnapshotID
+	response.Status = fmt.Sprintf("Removed %d duplicates", response.Removed)
+	return response, nil
+}
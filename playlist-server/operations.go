@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// addTracksToUserPlaylist snapshots the playlist, appends the tracks and records the add operation so that it can be undone.
+// Nothing is written when there are no tracks
+func (playlistServer *PlaylistServer) addTracksToUserPlaylist(ctx context.Context, userID string, playlistID string, operation string, uris []string, accessToken string) (database.PlaylistOperation, error) {
+	if len(uris) == 0 {
+		return database.PlaylistOperation{}, nil
+	}
+
+	before, err := playlistServer.snapshotPlaylist(ctx, userID, playlistID, operation, accessToken)
+	if err != nil {
+		return database.PlaylistOperation{}, err
+	}
+
+	snapshotID, err := addTracksToPlaylistInChunks(playlistID, uris, accessToken)
+	if err != nil {
+		return database.PlaylistOperation{}, err
+	}
+	playlistServer.recordDeliveredTracks(userID, playlistID, uris)
+
+	return playlistServer.recordPlaylistOperation(ctx, userID, playlistID, operation, uris, len(before.Uris), snapshotID), nil
+}
+
+// replaceUserPlaylistTracks snapshots the playlist and replaces its tracks. Replacing can't be undone as an operation,
+// so the playlist is rolled back by restoring the snapshot instead. Returns the snapshot ID of the playlist after the write
+func (playlistServer *PlaylistServer) replaceUserPlaylistTracks(ctx context.Context, userID string, playlistID string, operation string, uris []string, accessToken string) (string, error) {
+	if _, err := playlistServer.snapshotPlaylist(ctx, userID, playlistID, operation, accessToken); err != nil {
+		return "", err
+	}
+	response, err := spotify.ReplacePlaylistTracks(playlistID, uris, accessToken)
+	if err != nil {
+		return "", err
+	}
+	playlistServer.recordDeliveredTracks(userID, playlistID, uris)
+	return response.SnapshotID, nil
+}
+
+// recordPlaylistOperation saves the tracks added from the start position. Failing to record is only logged since
+// the tracks are already in the playlist. The playlist can still be restored from its snapshot
+func (playlistServer *PlaylistServer) recordPlaylistOperation(ctx context.Context, userID string, playlistID string, operation string, uris []string, start int, snapshotID string) database.PlaylistOperation {
+	positions := make([]int32, 0, len(uris))
+	for i := range uris {
+		positions = append(positions, int32(start+i))
+	}
+
+	playlistOperation, err := playlistServer.DB.CreatePlaylistOperation(ctx, database.CreatePlaylistOperationParams{
+		UserID:            userID,
+		PlaylistID:        playlistID,
+		Operation:         operation,
+		Uris:              uris,
+		Positions:         positions,
+		SpotifySnapshotID: snapshotID,
+	})
+	if err != nil {
+		slog.Error("Error recording the playlist operation", "playlistID", playlistID, "operation", operation, "error", err)
+		return database.PlaylistOperation{PlaylistID: playlistID, Operation: operation, Positions: positions, SpotifySnapshotID: snapshotID}
+	}
+	return playlistOperation
+}
+
+// UndoOperation removes exactly the tracks added by the operation. It fails without changing the playlist when
+// any of the added tracks is no longer at its recorded position, ex. the user moved or removed tracks since then
+func (playlistServer *PlaylistServer) UndoOperation(ctx context.Context, req *proto.UndoOperationRequest) (*proto.UndoOperationResponse, error) {
+	var playlistOperation database.PlaylistOperation
+	var err error
+	if req.Id == 0 {
+		playlistOperation, err = playlistServer.DB.GetLastPlaylistOperation(ctx, req.UserID)
+	} else {
+		playlistOperation, err = playlistServer.DB.GetPlaylistOperation(ctx, database.GetPlaylistOperationParams{
+			ID:     req.Id,
+			UserID: req.UserID,
+		})
+	}
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("playlist operation not found")
+	} else if err != nil {
+		slog.Error("Error getting the playlist operation", "id", req.Id, "error", err)
+		return nil, err
+	}
+	if playlistOperation.UndoneAt.Valid {
+		return nil, fmt.Errorf("playlist operation %d is already undone", playlistOperation.ID)
+	}
+
+	// The snapshot is also the current playlist to check the positions against
+	current, err := playlistServer.snapshotPlaylist(ctx, req.UserID, playlistOperation.PlaylistID, operationUndo, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.SpotifySnapshotID != playlistOperation.SpotifySnapshotID {
+		for i, uri := range playlistOperation.Uris {
+			position := int(playlistOperation.Positions[i])
+			if position >= len(current.Uris) || current.Uris[position] != uri {
+				slog.Info("Playlist changed since the operation", "id", playlistOperation.ID, "playlistID", playlistOperation.PlaylistID, "position", position)
+				return nil, status.Errorf(codes.FailedPrecondition, "playlist changed since the operation (track %s is no longer at position %d). Restore a snapshot instead", uri, position)
+			}
+		}
+	}
+
+	tracks := make([]spotify.RemoveTrack, 0, len(playlistOperation.Uris))
+	for i, uri := range playlistOperation.Uris {
+		tracks = append(tracks, spotify.RemoveTrack{URI: uri, Positions: []int{int(playlistOperation.Positions[i])}})
+	}
+
+	response, err := spotify.RemovePlaylistTracksAt(playlistOperation.PlaylistID, tracks, current.SpotifySnapshotID, req.AccessToken)
+	if err != nil {
+		slog.Error("Error removing the tracks of the operation", "id", playlistOperation.ID, "playlistID", playlistOperation.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error removing the tracks of the operation: %v", err)
+	}
+
+	playlistOperation.UndoneAt = sql.NullTime{Time: time.Now(), Valid: true}
+	err = playlistServer.DB.UpdatePlaylistOperationUndone(ctx, database.UpdatePlaylistOperationUndoneParams{
+		ID:       playlistOperation.ID,
+		UndoneAt: playlistOperation.UndoneAt,
+	})
+	if err != nil {
+		slog.Error("Error marking the playlist operation undone", "id", playlistOperation.ID, "error", err)
+	}
+
+	slog.Info("Undid playlist operation", "id", playlistOperation.ID, "playlistID", playlistOperation.PlaylistID, "tracks", len(tracks))
+
+	return &proto.UndoOperationResponse{
+		Status:            fmt.Sprintf("Removed %d tracks added by %s", len(tracks), playlistOperation.Operation),
+		Operation:         convertPlaylistOperationToProto(playlistOperation),
+		SpotifySnapshotID: response.SnapshotID,
+	}, nil
+}
+
+func convertPlaylistOperationToProto(playlistOperation database.PlaylistOperation) *proto.PlaylistOperation {
+	protoOperation := &proto.PlaylistOperation{
+		Id:                playlistOperation.ID,
+		PlaylistID:        playlistOperation.PlaylistID,
+		Operation:         playlistOperation.Operation,
+		Uris:              playlistOperation.Uris,
+		Positions:         playlistOperation.Positions,
+		SpotifySnapshotID: playlistOperation.SpotifySnapshotID,
+		CreatedAt:         playlistOperation.CreatedAt.Format(time.RFC3339),
+	}
+	if playlistOperation.UndoneAt.Valid {
+		protoOperation.UndoneAt = playlistOperation.UndoneAt.Time.Format(time.RFC3339)
+	}
+	return protoOperation
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Visibility of the playlist for UpdatePlaylistDetails
+const (
+	playlistVisibilityPublic        = "public"
+	playlistVisibilityPrivate       = "private"
+	playlistVisibilityCollaborative = "collaborative" // private and editable by the invited users
+)
+
+// UpdatePlaylistDetails renames the playlist and changes its description and visibility. Empty fields are left unchanged
+func (playlistServer *PlaylistServer) UpdatePlaylistDetails(ctx context.Context, req *proto.UpdatePlaylistDetailsRequest) (*proto.UpdatePlaylistDetailsResponse, error) {
+	if req.PlaylistID == "" {
+		return nil, fmt.Errorf("playlistID is required")
+	}
+
+	var details spotify.UpdatePlaylistDetailsRequest
+	var changed []string
+	if name := strings.TrimSpace(req.Name); name != "" {
+		details.Name = &name
+		changed = append(changed, "name")
+	}
+	if req.Description != "" || req.ClearDescription {
+		details.Description = &req.Description
+		changed = append(changed, "description")
+	}
+
+	public, collaborative := false, false
+	switch req.Visibility {
+	case "":
+	case playlistVisibilityPublic:
+		public = true
+		details.Public, details.Collaborative = &public, &collaborative
+	case playlistVisibilityPrivate:
+		details.Public, details.Collaborative = &public, &collaborative
+	case playlistVisibilityCollaborative:
+		collaborative = true
+		details.Public, details.Collaborative = &public, &collaborative
+	default:
+		return nil, fmt.Errorf("invalid visibility: %s (expected %s, %s or %s)", req.Visibility, playlistVisibilityPublic, playlistVisibilityPrivate, playlistVisibilityCollaborative)
+	}
+	if req.Visibility != "" {
+		changed = append(changed, "visibility")
+	}
+
+	if len(changed) == 0 {
+		return nil, fmt.Errorf("nothing to update")
+	}
+
+	err := spotify.UpdatePlaylistDetails(req.PlaylistID, details, req.AccessToken)
+	if err != nil {
+		slog.Error("Error updating the playlist details", "playlistID", req.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error updating the playlist details: %v", err)
+	}
+
+	slog.Info("Updated playlist details", "playlistID", req.PlaylistID, "changed", changed)
+
+	return &proto.UpdatePlaylistDetailsResponse{
+		Status: fmt.Sprintf("Updated %s", strings.Join(changed, ", ")),
+	}, nil
+}
+
+// RemovePlaylistTracks removes every occurrence of the URIs and the tracks at the positions after snapshotting the playlist
+func (playlistServer *PlaylistServer) RemovePlaylistTracks(ctx context.Context, req *proto.RemovePlaylistTracksRequest) (*proto.RemovePlaylistTracksResponse, error) {
+	if req.PlaylistID == "" {
+		return nil, fmt.Errorf("playlistID is required")
+	}
+	if len(req.Uris) == 0 && len(req.Tracks) == 0 {
+		return nil, fmt.Errorf("uris or tracks are required")
+	}
+
+	current, err := spotify.GetPlaylistSnapshot(req.PlaylistID, req.AccessToken)
+	if err != nil {
+		slog.Error("Error getting the playlist to remove the tracks", "playlistID", req.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error getting the playlist: %v", err)
+	}
+
+	// Without the snapshot ID the positions are checked against the current playlist
+	snapshotID := req.SnapshotID
+	if len(req.Tracks) > 0 && snapshotID == "" {
+		if err := checkTrackPositions(current.URIs, req.Tracks); err != nil {
+			return nil, err
+		}
+		snapshotID = current.SnapshotID
+	}
+
+	// Only snapshotted once the tracks are going to be removed
+	backup, err := playlistServer.savePlaylistSnapshot(ctx, req.UserID, req.PlaylistID, operationRemoveTracks, current)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &proto.RemovePlaylistTracksResponse{BackupSnapshotID: backup.ID}
+	removed := 0
+
+	// Positions first since removing the URIs moves the tracks after them
+	if len(req.Tracks) > 0 {
+		tracks := make([]spotify.RemoveTrack, 0, len(req.Tracks))
+		for _, track := range req.Tracks {
+			tracks = append(tracks, spotify.RemoveTrack{URI: track.Uri, Positions: []int{int(track.Position)}})
+		}
+
+		removeResponse, err := spotify.RemovePlaylistTracksAt(req.PlaylistID, tracks, snapshotID, req.AccessToken)
+		if err != nil {
+			slog.Error("Error removing the tracks at the positions", "playlistID", req.PlaylistID, "error", err)
+			return nil, fmt.Errorf("error removing the tracks: %v", err)
+		}
+		response.SpotifySnapshotID = removeResponse.SnapshotID
+		removed += len(tracks)
+	}
+
+	if len(req.Uris) > 0 {
+		removeResponse, err := spotify.RemovePlaylistTracks(req.PlaylistID, req.Uris, req.AccessToken)
+		if err != nil {
+			slog.Error("Error removing the tracks", "playlistID", req.PlaylistID, "error", err)
+			return nil, fmt.Errorf("error removing the tracks: %v", err)
+		}
+		response.SpotifySnapshotID = removeResponse.SnapshotID
+
+		removedPositions := make(map[int32]bool)
+		for _, track := range req.Tracks {
+			removedPositions[track.Position] = true
+		}
+		for position, uri := range current.URIs {
+			if !removedPositions[int32(position)] && slices.Contains(req.Uris, uri) {
+				removed++
+			}
+		}
+	}
+
+	slog.Info("Removed playlist tracks", "playlistID", req.PlaylistID, "removed", removed)
+
+	response.Status = fmt.Sprintf("Removed %d tracks", removed)
+	return response, nil
+}
+
+// ReorderPlaylistTracks moves the range of tracks after snapshotting the playlist
+func (playlistServer *PlaylistServer) ReorderPlaylistTracks(ctx context.Context, req *proto.ReorderPlaylistTracksRequest) (*proto.ReorderPlaylistTracksResponse, error) {
+	if req.PlaylistID == "" {
+		return nil, fmt.Errorf("playlistID is required")
+	}
+	rangeLength := req.RangeLength
+	if rangeLength == 0 {
+		rangeLength = 1
+	}
+	if req.RangeStart < 0 || req.InsertBefore < 0 || rangeLength < 0 {
+		return nil, fmt.Errorf("rangeStart, insertBefore and rangeLength can't be negative")
+	}
+
+	current, err := spotify.GetPlaylistSnapshot(req.PlaylistID, req.AccessToken)
+	if err != nil {
+		slog.Error("Error getting the playlist to reorder the tracks", "playlistID", req.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error getting the playlist: %v", err)
+	}
+
+	snapshotID := req.SnapshotID
+	if snapshotID == "" {
+		total := int32(len(current.URIs))
+		if req.RangeStart+rangeLength > total || req.InsertBefore > total {
+			return nil, fmt.Errorf("range is out of the playlist of %d tracks", total)
+		}
+		snapshotID = current.SnapshotID
+	}
+
+	// Only snapshotted once the tracks are going to be moved
+	backup, err := playlistServer.savePlaylistSnapshot(ctx, req.UserID, req.PlaylistID, operationReorderTracks, current)
+	if err != nil {
+		return nil, err
+	}
+
+	reorderResponse, err := spotify.ReorderPlaylistTracks(req.PlaylistID, spotify.ReorderTracksRequest{
+		RangeStart:   int(req.RangeStart),
+		InsertBefore: int(req.InsertBefore),
+		RangeLength:  int(rangeLength),
+		SnapshotID:   snapshotID,
+	}, req.AccessToken)
+	if err != nil {
+		slog.Error("Error reordering the playlist tracks", "playlistID", req.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error reordering the tracks: %v", err)
+	}
+
+	return &proto.ReorderPlaylistTracksResponse{
+		Status:            fmt.Sprintf("Moved %d tracks from %d to before %d", rangeLength, req.RangeStart, req.InsertBefore),
+		BackupSnapshotID:  backup.ID,
+		SpotifySnapshotID: reorderResponse.SnapshotID,
+	}, nil
+}
+
+// UnfollowPlaylist removes the playlist from the user's library (deletes it when it's the user's own playlist)
+// and stops its chart subscription so that the scheduler doesn't write to it anymore
+func (playlistServer *PlaylistServer) UnfollowPlaylist(ctx context.Context, req *proto.UnfollowPlaylistRequest) (*proto.UnfollowPlaylistResponse, error) {
+	if req.PlaylistID == "" {
+		return nil, fmt.Errorf("playlistID is required")
+	}
+
+	err := spotify.UnfollowPlaylist(req.PlaylistID, req.AccessToken)
+	if err != nil {
+		slog.Error("Error unfollowing the playlist", "playlistID", req.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error unfollowing the playlist: %v", err)
+	}
+
+	response := &proto.UnfollowPlaylistResponse{Status: "Unfollowed the playlist"}
+
+	deleted, err := playlistServer.DB.DeletePlaylistSubscriptionByPlaylist(ctx, database.DeletePlaylistSubscriptionByPlaylistParams{
+		UserID:     req.UserID,
+		PlaylistID: req.PlaylistID,
+	})
+	if err != nil {
+		slog.Error("Error deleting the subscription of the unfollowed playlist", "playlistID", req.PlaylistID, "error", err)
+	} else if deleted > 0 {
+		response.SubscriptionRemoved = true
+		response.Status += " and removed its chart subscription"
+	}
+
+	slog.Info("Unfollowed playlist", "userID", req.UserID, "playlistID", req.PlaylistID, "subscriptionRemoved", response.SubscriptionRemoved)
+
+	return response, nil
+}
+
+// checkTrackPositions makes sure every track is still at its position of the current playlist
+func checkTrackPositions(currentURIs []string, tracks []*proto.PlaylistTrackPosition) error {
+	for _, track := range tracks {
+		position := int(track.Position)
+		if position < 0 || position >= len(currentURIs) || currentURIs[position] != track.Uri {
+			return status.Errorf(codes.FailedPrecondition, "track %s is not at position %d", track.Uri, position)
+		}
+	}
+	return nil
+}
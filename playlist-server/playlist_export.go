@@ -0,0 +1,305 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+)
+
+// Formats supported by ExportPlaylist in addition to csv and json
+const (
+	exportFormatM3U  = "m3u"
+	exportFormatXSPF = "xspf"
+)
+
+var playlistTrackCSVHeader = []string{"title", "artist", "album", "duration_ms", "isrc", "spotify_uri", "added_at"}
+
+// playlistTrackExport is a single track of the playlist export
+type playlistTrackExport struct {
+	Title      string `json:"title"`
+	Artist     string `json:"artist"`
+	Album      string `json:"album"`
+	DurationMs int    `json:"durationMs"`
+	ISRC       string `json:"isrc"`
+	SpotifyURI string `json:"spotifyURI"`
+	AddedAt    string `json:"addedAt"`
+}
+
+// playlistExportWriter encodes the playlist in the export format. Tracks are encoded a page at a time
+// so that the export can be streamed while the next page is fetched from spotify
+type playlistExportWriter interface {
+	contentType() string
+	header(name string) ([]byte, error)
+	tracks(tracks []playlistTrackExport) ([]byte, error)
+	footer() []byte
+}
+
+func newPlaylistExportWriter(format string) (playlistExportWriter, error) {
+	switch format {
+	case exportFormatCSV:
+		return &csvPlaylistWriter{}, nil
+	case exportFormatJSON:
+		return &jsonPlaylistWriter{}, nil
+	case exportFormatM3U:
+		return &m3uPlaylistWriter{}, nil
+	case exportFormatXSPF:
+		return &xspfPlaylistWriter{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// ExportPlaylist streams every track of the playlist in the requested format
+func (playlistServer *PlaylistServer) ExportPlaylist(req *proto.ExportPlaylistRequest, stream proto.PlaylistService_ExportPlaylistServer) error {
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = exportFormatCSV
+	}
+	writer, err := newPlaylistExportWriter(format)
+	if err != nil {
+		return err
+	}
+
+	name, err := spotify.GetPlaylistName(req.PlaylistID, req.AccessToken)
+	if err != nil {
+		slog.Error("Error getting the playlist to export", "playlistID", req.PlaylistID, "error", err)
+		return fmt.Errorf("error getting the playlist: %v", err)
+	}
+
+	header, err := writer.header(name)
+	if err != nil {
+		return err
+	}
+	err = stream.Send(&proto.ExportPlaylistChunk{
+		ContentType: writer.contentType(),
+		FileName:    exportFileName(name, format),
+		Data:        header,
+	})
+	if err != nil {
+		return err
+	}
+
+	exported := 0
+	err = spotify.GetPlaylistItemPages(req.PlaylistID, req.AccessToken, func(items []spotify.PlaylistItem) error {
+		tracks := make([]playlistTrackExport, 0, len(items))
+		for _, item := range items {
+			if item.Track == nil || item.Track.URI == "" {
+				continue
+			}
+			tracks = append(tracks, convertPlaylistItemToExport(item))
+		}
+
+		data, err := writer.tracks(tracks)
+		if err != nil {
+			return err
+		}
+		exported += len(tracks)
+		return stream.Send(&proto.ExportPlaylistChunk{Data: data})
+	})
+	if err != nil {
+		slog.Error("Error exporting the playlist", "playlistID", req.PlaylistID, "format", format, "error", err)
+		return fmt.Errorf("error exporting the playlist: %v", err)
+	}
+
+	slog.Info("Exported playlist", "playlistID", req.PlaylistID, "format", format, "tracks", exported)
+
+	return stream.Send(&proto.ExportPlaylistChunk{Data: writer.footer()})
+}
+
+func convertPlaylistItemToExport(item spotify.PlaylistItem) playlistTrackExport {
+	artists := make([]string, 0, len(item.Track.Artists))
+	for _, artist := range item.Track.Artists {
+		artists = append(artists, artist.Name)
+	}
+
+	return playlistTrackExport{
+		Title:      item.Track.Name,
+		Artist:     strings.Join(artists, ", "),
+		Album:      item.Track.Album.Name,
+		DurationMs: item.Track.DurationMs,
+		ISRC:       item.Track.ExternalIDs.ISRC,
+		SpotifyURI: item.Track.URI,
+		AddedAt:    item.AddedAt,
+	}
+}
+
+// exportFileName keeps the letters and digits of the playlist name. ex) "Top 100: 2024" -> "Top_100__2024.csv"
+func exportFileName(name string, format string) string {
+	fileName := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' {
+			return r
+		}
+		return '_'
+	}, name)
+	if fileName == "" {
+		fileName = "playlist"
+	}
+	return fileName + "." + format
+}
+
+// spotifyTrackURL converts the track URI to the link which can be opened outside the spotify app
+// ex) spotify:track:4uLU6hMCjMI75M1A2tKUQC -> https://open.spotify.com/track/4uLU6hMCjMI75M1A2tKUQC
+func spotifyTrackURL(uri string) string {
+	if id, ok := strings.CutPrefix(uri, "spotify:track:"); ok {
+		return "https://open.spotify.com/track/" + id
+	}
+	return uri
+}
+
+type csvPlaylistWriter struct{}
+
+func (writer *csvPlaylistWriter) contentType() string {
+	return "text/csv"
+}
+
+func (writer *csvPlaylistWriter) header(name string) ([]byte, error) {
+	return writeCSVRecords([][]string{playlistTrackCSVHeader})
+}
+
+func (writer *csvPlaylistWriter) tracks(tracks []playlistTrackExport) ([]byte, error) {
+	records := make([][]string, 0, len(tracks))
+	for _, track := range tracks {
+		records = append(records, []string{
+			track.Title,
+			track.Artist,
+			track.Album,
+			strconv.Itoa(track.DurationMs),
+			track.ISRC,
+			track.SpotifyURI,
+			track.AddedAt,
+		})
+	}
+	return writeCSVRecords(records)
+}
+
+func (writer *csvPlaylistWriter) footer() []byte {
+	return nil
+}
+
+func writeCSVRecords(records [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	if err := csvWriter.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// jsonPlaylistWriter writes {"name": ..., "tracks": [...]}
+type jsonPlaylistWriter struct {
+	written int
+}
+
+func (writer *jsonPlaylistWriter) contentType() string {
+	return "application/json"
+}
+
+func (writer *jsonPlaylistWriter) header(name string) ([]byte, error) {
+	encodedName, err := json.Marshal(name)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(fmt.Sprintf("{\n  \"name\": %s,\n  \"tracks\": [", encodedName)), nil
+}
+
+func (writer *jsonPlaylistWriter) tracks(tracks []playlistTrackExport) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, track := range tracks {
+		data, err := json.MarshalIndent(track, "    ", "  ")
+		if err != nil {
+			return nil, err
+		}
+		if writer.written > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n    ")
+		buf.Write(data)
+		writer.written++
+	}
+	return buf.Bytes(), nil
+}
+
+func (writer *jsonPlaylistWriter) footer() []byte {
+	return []byte("\n  ]\n}\n")
+}
+
+// m3uPlaylistWriter writes the extended M3U with the spotify links as the locations
+type m3uPlaylistWriter struct{}
+
+func (writer *m3uPlaylistWriter) contentType() string {
+	return "audio/x-mpegurl"
+}
+
+func (writer *m3uPlaylistWriter) header(name string) ([]byte, error) {
+	return []byte(fmt.Sprintf("#EXTM3U\n#PLAYLIST:%s\n", strings.ReplaceAll(name, "\n", " "))), nil
+}
+
+func (writer *m3uPlaylistWriter) tracks(tracks []playlistTrackExport) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, track := range tracks {
+		fmt.Fprintf(&buf, "#EXTINF:%d,%s - %s\n", track.DurationMs/1000, track.Artist, track.Title)
+		if track.Album != "" {
+			fmt.Fprintf(&buf, "#EXTALB:%s\n", track.Album)
+		}
+		fmt.Fprintf(&buf, "%s\n", spotifyTrackURL(track.SpotifyURI))
+	}
+	return buf.Bytes(), nil
+}
+
+func (writer *m3uPlaylistWriter) footer() []byte {
+	return nil
+}
+
+// xspfPlaylistWriter writes the XSPF playlist. ISRC and the added time are kept in the track extension
+type xspfPlaylistWriter struct{}
+
+func (writer *xspfPlaylistWriter) contentType() string {
+	return "application/xspf+xml"
+}
+
+func (writer *xspfPlaylistWriter) header(name string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString("<playlist version=\"1\" xmlns=\"http://xspf.org/ns/0/\">\n")
+	fmt.Fprintf(&buf, "  <title>%s</title>\n", xmlEscape(name))
+	buf.WriteString("  <trackList>\n")
+	return buf.Bytes(), nil
+}
+
+func (writer *xspfPlaylistWriter) tracks(tracks []playlistTrackExport) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, track := range tracks {
+		buf.WriteString("    <track>\n")
+		fmt.Fprintf(&buf, "      <location>%s</location>\n", xmlEscape(spotifyTrackURL(track.SpotifyURI)))
+		fmt.Fprintf(&buf, "      <identifier>%s</identifier>\n", xmlEscape(track.SpotifyURI))
+		fmt.Fprintf(&buf, "      <title>%s</title>\n", xmlEscape(track.Title))
+		fmt.Fprintf(&buf, "      <creator>%s</creator>\n", xmlEscape(track.Artist))
+		fmt.Fprintf(&buf, "      <album>%s</album>\n", xmlEscape(track.Album))
+		fmt.Fprintf(&buf, "      <duration>%d</duration>\n", track.DurationMs)
+		buf.WriteString("      <extension application=\"https://open.spotify.com\">\n")
+		fmt.Fprintf(&buf, "        <isrc>%s</isrc>\n", xmlEscape(track.ISRC))
+		fmt.Fprintf(&buf, "        <addedAt>%s</addedAt>\n", xmlEscape(track.AddedAt))
+		buf.WriteString("      </extension>\n")
+		buf.WriteString("    </track>\n")
+	}
+	return buf.Bytes(), nil
+}
+
+func (writer *xspfPlaylistWriter) footer() []byte {
+	return []byte("  </trackList>\n</playlist>\n")
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -171,6 +171,21 @@ type CreateMelonTop100Request struct {
 	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
 	PlaylistID  string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
 	Date        string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// Also add the tracks matched with a low confidence which are waiting for the review
+	IncludePendingMatches bool `protobuf:"varint,5,opt,name=includePendingMatches,proto3" json:"includePendingMatches,omitempty"`
+	// Optional rank range (inclusive). 0 means no bound. ex) fromRank 51, toRank 100
+	FromRank int32 `protobuf:"varint,6,opt,name=fromRank,proto3" json:"fromRank,omitempty"`
+	ToRank   int32 `protobuf:"varint,7,opt,name=toRank,proto3" json:"toRank,omitempty"`
+	// Optional max number of tracks to add after the other filters. ex) limit 10 for the top 10
+	Limit int32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Tracks by these artists (spotify or melon artist name) are left out
+	ExcludeArtists []string `protobuf:"bytes,9,rep,name=excludeArtists,proto3" json:"excludeArtists,omitempty"`
+	// Add the tracks in the background and return the jobID which can be checked with GetCreateMelonTop100Job
+	Async bool `protobuf:"varint,10,opt,name=async,proto3" json:"async,omitempty"`
+	// Only add the tracks this user has never received from melongo
+	FreshOnly bool `protobuf:"varint,11,opt,name=freshOnly,proto3" json:"freshOnly,omitempty"`
+	// With freshOnly, only skip the tracks received within the last N days. 0 skips every track ever received
+	FreshWithinDays int32 `protobuf:"varint,12,opt,name=freshWithinDays,proto3" json:"freshWithinDays,omitempty"`
 }
 
 func (x *CreateMelonTop100Request) Reset() {
@@ -233,12 +248,86 @@ func (x *CreateMelonTop100Request) GetDate() string {
 	return ""
 }
 
+func (x *CreateMelonTop100Request) GetIncludePendingMatches() bool {
+	if x != nil {
+		return x.IncludePendingMatches
+	}
+	return false
+}
+
+func (x *CreateMelonTop100Request) GetFromRank() int32 {
+	if x != nil {
+		return x.FromRank
+	}
+	return 0
+}
+
+func (x *CreateMelonTop100Request) GetToRank() int32 {
+	if x != nil {
+		return x.ToRank
+	}
+	return 0
+}
+
+func (x *CreateMelonTop100Request) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CreateMelonTop100Request) GetExcludeArtists() []string {
+	if x != nil {
+		return x.ExcludeArtists
+	}
+	return nil
+}
+
+func (x *CreateMelonTop100Request) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *CreateMelonTop100Request) GetFreshOnly() bool {
+	if x != nil {
+		return x.FreshOnly
+	}
+	return false
+}
+
+func (x *CreateMelonTop100Request) GetFreshWithinDays() int32 {
+	if x != nil {
+		return x.FreshWithinDays
+	}
+	return 0
+}
+
 type CreateMelonTop100Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Number of chart entries of the date, including the ones that weren't found on spotify
+	ChartEntries int32 `protobuf:"varint,2,opt,name=chartEntries,proto3" json:"chartEntries,omitempty"`
+	// Number of tracks added to the playlist
+	Added int32 `protobuf:"varint,3,opt,name=added,proto3" json:"added,omitempty"`
+	// Chart entries within the requested ranks that couldn't be added because they weren't found on spotify
+	MissingTracks []*MissedTrack `protobuf:"bytes,4,rep,name=missingTracks,proto3" json:"missingTracks,omitempty"`
+	// Low confidence matches left out until they are approved
+	PendingReview int32 `protobuf:"varint,5,opt,name=pendingReview,proto3" json:"pendingReview,omitempty"`
+	// Snapshot ID of the playlist after the tracks were added
+	SnapshotID string `protobuf:"bytes,6,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+	// Only set for the async request
+	JobID string `protobuf:"bytes,7,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	// Set when adding the tracks to the playlist failed in the background
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// Tracks left out because the user already received them (freshOnly only)
+	AlreadyDelivered int32 `protobuf:"varint,9,opt,name=alreadyDelivered,proto3" json:"alreadyDelivered,omitempty"`
+	// ID of the recorded add operation which can be undone with UndoOperation
+	OperationID int32 `protobuf:"varint,10,opt,name=operationID,proto3" json:"operationID,omitempty"`
 }
 
 func (x *CreateMelonTop100Response) Reset() {
@@ -280,6 +369,188 @@ func (x *CreateMelonTop100Response) GetStatus() string {
 	return ""
 }
 
+func (x *CreateMelonTop100Response) GetChartEntries() int32 {
+	if x != nil {
+		return x.ChartEntries
+	}
+	return 0
+}
+
+func (x *CreateMelonTop100Response) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *CreateMelonTop100Response) GetMissingTracks() []*MissedTrack {
+	if x != nil {
+		return x.MissingTracks
+	}
+	return nil
+}
+
+func (x *CreateMelonTop100Response) GetPendingReview() int32 {
+	if x != nil {
+		return x.PendingReview
+	}
+	return 0
+}
+
+func (x *CreateMelonTop100Response) GetSnapshotID() string {
+	if x != nil {
+		return x.SnapshotID
+	}
+	return ""
+}
+
+func (x *CreateMelonTop100Response) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *CreateMelonTop100Response) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CreateMelonTop100Response) GetAlreadyDelivered() int32 {
+	if x != nil {
+		return x.AlreadyDelivered
+	}
+	return 0
+}
+
+func (x *CreateMelonTop100Response) GetOperationID() int32 {
+	if x != nil {
+		return x.OperationID
+	}
+	return 0
+}
+
+type GetCreateMelonTop100JobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID  string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *GetCreateMelonTop100JobRequest) Reset() {
+	*x = GetCreateMelonTop100JobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCreateMelonTop100JobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreateMelonTop100JobRequest) ProtoMessage() {}
+
+func (x *GetCreateMelonTop100JobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreateMelonTop100JobRequest.ProtoReflect.Descriptor instead.
+func (*GetCreateMelonTop100JobRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCreateMelonTop100JobRequest) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *GetCreateMelonTop100JobRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetCreateMelonTop100JobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID  string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Only set once the job is done
+	Result *CreateMelonTop100Response `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetCreateMelonTop100JobResponse) Reset() {
+	*x = GetCreateMelonTop100JobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCreateMelonTop100JobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreateMelonTop100JobResponse) ProtoMessage() {}
+
+func (x *GetCreateMelonTop100JobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreateMelonTop100JobResponse.ProtoReflect.Descriptor instead.
+func (*GetCreateMelonTop100JobResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCreateMelonTop100JobResponse) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *GetCreateMelonTop100JobResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetCreateMelonTop100JobResponse) GetResult() *CreateMelonTop100Response {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 type SaveMelonTop100DBRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -291,7 +562,7 @@ type SaveMelonTop100DBRequest struct {
 func (x *SaveMelonTop100DBRequest) Reset() {
 	*x = SaveMelonTop100DBRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[4]
+		mi := &file_playlist_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -304,7 +575,7 @@ func (x *SaveMelonTop100DBRequest) String() string {
 func (*SaveMelonTop100DBRequest) ProtoMessage() {}
 
 func (x *SaveMelonTop100DBRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[4]
+	mi := &file_playlist_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +588,7 @@ func (x *SaveMelonTop100DBRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveMelonTop100DBRequest.ProtoReflect.Descriptor instead.
 func (*SaveMelonTop100DBRequest) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{4}
+	return file_playlist_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SaveMelonTop100DBRequest) GetAccessToken() string {
@@ -338,7 +609,7 @@ type SaveMelonTop100DBResponse struct {
 func (x *SaveMelonTop100DBResponse) Reset() {
 	*x = SaveMelonTop100DBResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[5]
+		mi := &file_playlist_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -351,7 +622,7 @@ func (x *SaveMelonTop100DBResponse) String() string {
 func (*SaveMelonTop100DBResponse) ProtoMessage() {}
 
 func (x *SaveMelonTop100DBResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[5]
+	mi := &file_playlist_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +635,7 @@ func (x *SaveMelonTop100DBResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveMelonTop100DBResponse.ProtoReflect.Descriptor instead.
 func (*SaveMelonTop100DBResponse) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{5}
+	return file_playlist_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SaveMelonTop100DBResponse) GetStatus() string {
@@ -383,12 +654,15 @@ type MissedTrack struct {
 	Title  string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Artist string `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
 	Date   string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// Number of chart days the track has been missed, including this date
+	DaysMissed      int32  `protobuf:"varint,5,opt,name=daysMissed,proto3" json:"daysMissed,omitempty"`
+	FirstMissedDate string `protobuf:"bytes,6,opt,name=firstMissedDate,proto3" json:"firstMissedDate,omitempty"`
 }
 
 func (x *MissedTrack) Reset() {
 	*x = MissedTrack{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[6]
+		mi := &file_playlist_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -401,7 +675,7 @@ func (x *MissedTrack) String() string {
 func (*MissedTrack) ProtoMessage() {}
 
 func (x *MissedTrack) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[6]
+	mi := &file_playlist_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,7 +688,7 @@ func (x *MissedTrack) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MissedTrack.ProtoReflect.Descriptor instead.
 func (*MissedTrack) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{6}
+	return file_playlist_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MissedTrack) GetRank() int32 {
@@ -445,6 +719,20 @@ func (x *MissedTrack) GetDate() string {
 	return ""
 }
 
+func (x *MissedTrack) GetDaysMissed() int32 {
+	if x != nil {
+		return x.DaysMissed
+	}
+	return 0
+}
+
+func (x *MissedTrack) GetFirstMissedDate() string {
+	if x != nil {
+		return x.FirstMissedDate
+	}
+	return ""
+}
+
 type GetMissedTracksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -457,7 +745,7 @@ type GetMissedTracksRequest struct {
 func (x *GetMissedTracksRequest) Reset() {
 	*x = GetMissedTracksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[7]
+		mi := &file_playlist_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -470,7 +758,7 @@ func (x *GetMissedTracksRequest) String() string {
 func (*GetMissedTracksRequest) ProtoMessage() {}
 
 func (x *GetMissedTracksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[7]
+	mi := &file_playlist_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,7 +771,7 @@ func (x *GetMissedTracksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMissedTracksRequest.ProtoReflect.Descriptor instead.
 func (*GetMissedTracksRequest) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{7}
+	return file_playlist_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetMissedTracksRequest) GetAccessToken() string {
@@ -511,7 +799,7 @@ type GetMissedTrackResponse struct {
 func (x *GetMissedTrackResponse) Reset() {
 	*x = GetMissedTrackResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[8]
+		mi := &file_playlist_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -524,7 +812,7 @@ func (x *GetMissedTrackResponse) String() string {
 func (*GetMissedTrackResponse) ProtoMessage() {}
 
 func (x *GetMissedTrackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[8]
+	mi := &file_playlist_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +825,7 @@ func (x *GetMissedTrackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMissedTrackResponse.ProtoReflect.Descriptor instead.
 func (*GetMissedTrackResponse) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{8}
+	return file_playlist_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetMissedTrackResponse) GetMissedTracks() []*MissedTrack {
@@ -547,36 +835,37 @@ func (x *GetMissedTrackResponse) GetMissedTracks() []*MissedTrack {
 	return nil
 }
 
-type ResolvedTrack struct {
+type TrackCandidate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rank         int32  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
-	MissedTitle  string `protobuf:"bytes,2,opt,name=missed_title,json=missedTitle,proto3" json:"missed_title,omitempty"`
-	MissedArtist string `protobuf:"bytes,3,opt,name=missed_artist,json=missedArtist,proto3" json:"missed_artist,omitempty"`
-	Title        string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Artist       string `protobuf:"bytes,5,opt,name=artist,proto3" json:"artist,omitempty"`
-	Date         string `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	Uri           string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Title         string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Artists       []string `protobuf:"bytes,3,rep,name=artists,proto3" json:"artists,omitempty"`
+	Album         string   `protobuf:"bytes,4,opt,name=album,proto3" json:"album,omitempty"`
+	AlbumImageUrl string   `protobuf:"bytes,5,opt,name=albumImageUrl,proto3" json:"albumImageUrl,omitempty"`
+	PreviewUrl    string   `protobuf:"bytes,6,opt,name=previewUrl,proto3" json:"previewUrl,omitempty"`
+	Score         float64  `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (x *ResolvedTrack) Reset() {
-	*x = ResolvedTrack{}
+func (x *TrackCandidate) Reset() {
+	*x = TrackCandidate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[9]
+		mi := &file_playlist_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResolvedTrack) String() string {
+func (x *TrackCandidate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolvedTrack) ProtoMessage() {}
+func (*TrackCandidate) ProtoMessage() {}
 
-func (x *ResolvedTrack) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[9]
+func (x *TrackCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,79 +876,86 @@ func (x *ResolvedTrack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolvedTrack.ProtoReflect.Descriptor instead.
-func (*ResolvedTrack) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TrackCandidate.ProtoReflect.Descriptor instead.
+func (*TrackCandidate) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ResolvedTrack) GetRank() int32 {
+func (x *TrackCandidate) GetUri() string {
 	if x != nil {
-		return x.Rank
+		return x.Uri
 	}
-	return 0
+	return ""
 }
 
-func (x *ResolvedTrack) GetMissedTitle() string {
+func (x *TrackCandidate) GetTitle() string {
 	if x != nil {
-		return x.MissedTitle
+		return x.Title
 	}
 	return ""
 }
 
-func (x *ResolvedTrack) GetMissedArtist() string {
+func (x *TrackCandidate) GetArtists() []string {
 	if x != nil {
-		return x.MissedArtist
+		return x.Artists
 	}
-	return ""
+	return nil
 }
 
-func (x *ResolvedTrack) GetTitle() string {
+func (x *TrackCandidate) GetAlbum() string {
 	if x != nil {
-		return x.Title
+		return x.Album
 	}
 	return ""
 }
 
-func (x *ResolvedTrack) GetArtist() string {
+func (x *TrackCandidate) GetAlbumImageUrl() string {
 	if x != nil {
-		return x.Artist
+		return x.AlbumImageUrl
 	}
 	return ""
 }
 
-func (x *ResolvedTrack) GetDate() string {
+func (x *TrackCandidate) GetPreviewUrl() string {
 	if x != nil {
-		return x.Date
+		return x.PreviewUrl
 	}
 	return ""
 }
 
-type ResolveMissedTracksRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TrackCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
 
-	AccessToken    string           `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	ResolvedTracks []*ResolvedTrack `protobuf:"bytes,2,rep,name=resolvedTracks,proto3" json:"resolvedTracks,omitempty"`
+type MissedTrackCandidates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MissedTrack *MissedTrack      `protobuf:"bytes,1,opt,name=missedTrack,proto3" json:"missedTrack,omitempty"`
+	Candidates  []*TrackCandidate `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
 }
 
-func (x *ResolveMissedTracksRequest) Reset() {
-	*x = ResolveMissedTracksRequest{}
+func (x *MissedTrackCandidates) Reset() {
+	*x = MissedTrackCandidates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[10]
+		mi := &file_playlist_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResolveMissedTracksRequest) String() string {
+func (x *MissedTrackCandidates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveMissedTracksRequest) ProtoMessage() {}
+func (*MissedTrackCandidates) ProtoMessage() {}
 
-func (x *ResolveMissedTracksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[10]
+func (x *MissedTrackCandidates) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,50 +966,52 @@ func (x *ResolveMissedTracksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveMissedTracksRequest.ProtoReflect.Descriptor instead.
-func (*ResolveMissedTracksRequest) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MissedTrackCandidates.ProtoReflect.Descriptor instead.
+func (*MissedTrackCandidates) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ResolveMissedTracksRequest) GetAccessToken() string {
+func (x *MissedTrackCandidates) GetMissedTrack() *MissedTrack {
 	if x != nil {
-		return x.AccessToken
+		return x.MissedTrack
 	}
-	return ""
+	return nil
 }
 
-func (x *ResolveMissedTracksRequest) GetResolvedTracks() []*ResolvedTrack {
+func (x *MissedTrackCandidates) GetCandidates() []*TrackCandidate {
 	if x != nil {
-		return x.ResolvedTracks
+		return x.Candidates
 	}
 	return nil
 }
 
-type ResolveMissedTracksResponse struct {
+type SuggestCandidatesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	Date        string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Limit       int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *ResolveMissedTracksResponse) Reset() {
-	*x = ResolveMissedTracksResponse{}
+func (x *SuggestCandidatesRequest) Reset() {
+	*x = SuggestCandidatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[11]
+		mi := &file_playlist_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResolveMissedTracksResponse) String() string {
+func (x *SuggestCandidatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveMissedTracksResponse) ProtoMessage() {}
+func (*SuggestCandidatesRequest) ProtoMessage() {}
 
-func (x *ResolveMissedTracksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[11]
+func (x *SuggestCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,43 +1022,57 @@ func (x *ResolveMissedTracksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveMissedTracksResponse.ProtoReflect.Descriptor instead.
-func (*ResolveMissedTracksResponse) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SuggestCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ResolveMissedTracksResponse) GetStatus() string {
+func (x *SuggestCandidatesRequest) GetAccessToken() string {
 	if x != nil {
-		return x.Status
+		return x.AccessToken
 	}
 	return ""
 }
 
-type GetUserPlaylistsRequest struct {
+func (x *SuggestCandidatesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SuggestCandidatesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestCandidatesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	MissedTracks []*MissedTrackCandidates `protobuf:"bytes,1,rep,name=missedTracks,proto3" json:"missedTracks,omitempty"`
 }
 
-func (x *GetUserPlaylistsRequest) Reset() {
-	*x = GetUserPlaylistsRequest{}
+func (x *SuggestCandidatesResponse) Reset() {
+	*x = SuggestCandidatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[12]
+		mi := &file_playlist_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserPlaylistsRequest) String() string {
+func (x *SuggestCandidatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPlaylistsRequest) ProtoMessage() {}
+func (*SuggestCandidatesResponse) ProtoMessage() {}
 
-func (x *GetUserPlaylistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[12]
+func (x *SuggestCandidatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,52 +1083,50 @@ func (x *GetUserPlaylistsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPlaylistsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPlaylistsRequest) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SuggestCandidatesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestCandidatesResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetUserPlaylistsRequest) GetAccessToken() string {
+func (x *SuggestCandidatesResponse) GetMissedTracks() []*MissedTrackCandidates {
 	if x != nil {
-		return x.AccessToken
+		return x.MissedTracks
 	}
-	return ""
+	return nil
 }
 
-type Playlist struct {
+type ResolvedTrack struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Next                     string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
-	Total                    int32  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	PlaylistPageURL          string `protobuf:"bytes,3,opt,name=playlistPageURL,proto3" json:"playlistPageURL,omitempty"`
-	DetailedPlaylistEndpoint string `protobuf:"bytes,4,opt,name=detailedPlaylistEndpoint,proto3" json:"detailedPlaylistEndpoint,omitempty"`
-	Name                     string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Description              string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	SpotifyPlaylistID        string `protobuf:"bytes,7,opt,name=spotifyPlaylistID,proto3" json:"spotifyPlaylistID,omitempty"`
-	ImageUrl                 string `protobuf:"bytes,8,opt,name=imageUrl,proto3" json:"imageUrl,omitempty"`
-	TotalTracks              int32  `protobuf:"varint,9,opt,name=totalTracks,proto3" json:"totalTracks,omitempty"`
-	TracksEndpoint           string `protobuf:"bytes,10,opt,name=tracksEndpoint,proto3" json:"tracksEndpoint,omitempty"`
+	Rank         int32  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	MissedTitle  string `protobuf:"bytes,2,opt,name=missed_title,json=missedTitle,proto3" json:"missed_title,omitempty"`
+	MissedArtist string `protobuf:"bytes,3,opt,name=missed_artist,json=missedArtist,proto3" json:"missed_artist,omitempty"`
+	Title        string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Artist       string `protobuf:"bytes,5,opt,name=artist,proto3" json:"artist,omitempty"`
+	Date         string `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	// Optional spotify track URI or URL. When set, it is used instead of searching title and artist
+	SpotifyURI string `protobuf:"bytes,7,opt,name=spotifyURI,proto3" json:"spotifyURI,omitempty"`
 }
 
-func (x *Playlist) Reset() {
-	*x = Playlist{}
+func (x *ResolvedTrack) Reset() {
+	*x = ResolvedTrack{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[13]
+		mi := &file_playlist_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Playlist) String() string {
+func (x *ResolvedTrack) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Playlist) ProtoMessage() {}
+func (*ResolvedTrack) ProtoMessage() {}
 
-func (x *Playlist) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[13]
+func (x *ResolvedTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,107 +1137,87 @@ func (x *Playlist) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Playlist.ProtoReflect.Descriptor instead.
-func (*Playlist) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *Playlist) GetNext() string {
-	if x != nil {
-		return x.Next
-	}
-	return ""
+// Deprecated: Use ResolvedTrack.ProtoReflect.Descriptor instead.
+func (*ResolvedTrack) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Playlist) GetTotal() int32 {
+func (x *ResolvedTrack) GetRank() int32 {
 	if x != nil {
-		return x.Total
+		return x.Rank
 	}
 	return 0
 }
 
-func (x *Playlist) GetPlaylistPageURL() string {
-	if x != nil {
-		return x.PlaylistPageURL
-	}
-	return ""
-}
-
-func (x *Playlist) GetDetailedPlaylistEndpoint() string {
+func (x *ResolvedTrack) GetMissedTitle() string {
 	if x != nil {
-		return x.DetailedPlaylistEndpoint
+		return x.MissedTitle
 	}
 	return ""
 }
 
-func (x *Playlist) GetName() string {
+func (x *ResolvedTrack) GetMissedArtist() string {
 	if x != nil {
-		return x.Name
+		return x.MissedArtist
 	}
 	return ""
 }
 
-func (x *Playlist) GetDescription() string {
+func (x *ResolvedTrack) GetTitle() string {
 	if x != nil {
-		return x.Description
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Playlist) GetSpotifyPlaylistID() string {
+func (x *ResolvedTrack) GetArtist() string {
 	if x != nil {
-		return x.SpotifyPlaylistID
+		return x.Artist
 	}
 	return ""
 }
 
-func (x *Playlist) GetImageUrl() string {
+func (x *ResolvedTrack) GetDate() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.Date
 	}
 	return ""
 }
 
-func (x *Playlist) GetTotalTracks() int32 {
-	if x != nil {
-		return x.TotalTracks
-	}
-	return 0
-}
-
-func (x *Playlist) GetTracksEndpoint() string {
+func (x *ResolvedTrack) GetSpotifyURI() string {
 	if x != nil {
-		return x.TracksEndpoint
+		return x.SpotifyURI
 	}
 	return ""
 }
 
-type GetUserPlaylistsResponse struct {
+type ResolveMissedTracksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Playlists   []*Playlist `protobuf:"bytes,1,rep,name=playlists,proto3" json:"playlists,omitempty"`
-	NextPageURL string      `protobuf:"bytes,2,opt,name=nextPageURL,proto3" json:"nextPageURL,omitempty"`
+	AccessToken    string           `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	ResolvedTracks []*ResolvedTrack `protobuf:"bytes,2,rep,name=resolvedTracks,proto3" json:"resolvedTracks,omitempty"`
+	UserID         string           `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *GetUserPlaylistsResponse) Reset() {
-	*x = GetUserPlaylistsResponse{}
+func (x *ResolveMissedTracksRequest) Reset() {
+	*x = ResolveMissedTracksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[14]
+		mi := &file_playlist_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserPlaylistsResponse) String() string {
+func (x *ResolveMissedTracksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPlaylistsResponse) ProtoMessage() {}
+func (*ResolveMissedTracksRequest) ProtoMessage() {}
 
-func (x *GetUserPlaylistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[14]
+func (x *ResolveMissedTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,53 +1228,65 @@ func (x *GetUserPlaylistsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPlaylistsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserPlaylistsResponse) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ResolveMissedTracksRequest.ProtoReflect.Descriptor instead.
+func (*ResolveMissedTracksRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetUserPlaylistsResponse) GetPlaylists() []*Playlist {
+func (x *ResolveMissedTracksRequest) GetAccessToken() string {
 	if x != nil {
-		return x.Playlists
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ResolveMissedTracksRequest) GetResolvedTracks() []*ResolvedTrack {
+	if x != nil {
+		return x.ResolvedTracks
 	}
 	return nil
 }
 
-func (x *GetUserPlaylistsResponse) GetNextPageURL() string {
+func (x *ResolveMissedTracksRequest) GetUserID() string {
 	if x != nil {
-		return x.NextPageURL
+		return x.UserID
 	}
 	return ""
 }
 
-type PlaylistTrack struct {
+// ResolveResult is the result of resolving a single missed track
+type ResolveResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title      string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Artist     string `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"`
-	Popularity int32  `protobuf:"varint,3,opt,name=popularity,proto3" json:"popularity,omitempty"`
-	Uri        string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	MissedTitle   string `protobuf:"bytes,1,opt,name=missed_title,json=missedTitle,proto3" json:"missed_title,omitempty"`
+	MissedArtist  string `protobuf:"bytes,2,opt,name=missed_artist,json=missedArtist,proto3" json:"missed_artist,omitempty"`
+	Resolved      bool   `protobuf:"varint,3,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	ResolvedURI   string `protobuf:"bytes,4,opt,name=resolvedURI,proto3" json:"resolvedURI,omitempty"`
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	MissedRemoved bool   `protobuf:"varint,6,opt,name=missedRemoved,proto3" json:"missedRemoved,omitempty"`
+	// Number of playlists already created for the missed dates that the resolved track was added to
+	PlaylistsUpdated int32 `protobuf:"varint,7,opt,name=playlistsUpdated,proto3" json:"playlistsUpdated,omitempty"`
 }
 
-func (x *PlaylistTrack) Reset() {
-	*x = PlaylistTrack{}
+func (x *ResolveResult) Reset() {
+	*x = ResolveResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[15]
+		mi := &file_playlist_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlaylistTrack) String() string {
+func (x *ResolveResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlaylistTrack) ProtoMessage() {}
+func (*ResolveResult) ProtoMessage() {}
 
-func (x *PlaylistTrack) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[15]
+func (x *ResolveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,386 +1297,9525 @@ func (x *PlaylistTrack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlaylistTrack.ProtoReflect.Descriptor instead.
-func (*PlaylistTrack) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ResolveResult.ProtoReflect.Descriptor instead.
+func (*ResolveResult) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PlaylistTrack) GetTitle() string {
+func (x *ResolveResult) GetMissedTitle() string {
 	if x != nil {
-		return x.Title
+		return x.MissedTitle
 	}
 	return ""
 }
 
-func (x *PlaylistTrack) GetArtist() string {
+func (x *ResolveResult) GetMissedArtist() string {
 	if x != nil {
-		return x.Artist
+		return x.MissedArtist
 	}
 	return ""
 }
 
-func (x *PlaylistTrack) GetPopularity() int32 {
+func (x *ResolveResult) GetResolved() bool {
 	if x != nil {
-		return x.Popularity
+		return x.Resolved
 	}
-	return 0
+	return false
 }
 
-func (x *PlaylistTrack) GetUri() string {
+func (x *ResolveResult) GetResolvedURI() string {
 	if x != nil {
-		return x.Uri
+		return x.ResolvedURI
 	}
 	return ""
 }
 
-type GetUserPlaylistTracksRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ResolveResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
 
-	AccessToken    string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	TracksEndpoint string `protobuf:"bytes,2,opt,name=tracksEndpoint,proto3" json:"tracksEndpoint,omitempty"`
+func (x *ResolveResult) GetMissedRemoved() bool {
+	if x != nil {
+		return x.MissedRemoved
+	}
+	return false
 }
 
-func (x *GetUserPlaylistTracksRequest) Reset() {
-	*x = GetUserPlaylistTracksRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ResolveResult) GetPlaylistsUpdated() int32 {
+	if x != nil {
+		return x.PlaylistsUpdated
 	}
+	return 0
 }
 
-func (x *GetUserPlaylistTracksRequest) String() string {
+// ResolveMissedTracksResponse contains the results of each resolved track.
+// For large batches, it only contains the jobID which can be checked with GetResolveJob
+type ResolveMissedTracksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*ResolveResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	JobID   string           `protobuf:"bytes,3,opt,name=jobID,proto3" json:"jobID,omitempty"`
+}
+
+func (x *ResolveMissedTracksResponse) Reset() {
+	*x = ResolveMissedTracksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveMissedTracksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPlaylistTracksRequest) ProtoMessage() {}
+func (*ResolveMissedTracksResponse) ProtoMessage() {}
 
-func (x *GetUserPlaylistTracksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[16]
+func (x *ResolveMissedTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMissedTracksResponse.ProtoReflect.Descriptor instead.
+func (*ResolveMissedTracksResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResolveMissedTracksResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ResolveMissedTracksResponse) GetResults() []*ResolveResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ResolveMissedTracksResponse) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+type GetResolveJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID  string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *GetResolveJobRequest) Reset() {
+	*x = GetResolveJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResolveJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResolveJobRequest) ProtoMessage() {}
+
+func (x *GetResolveJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResolveJobRequest.ProtoReflect.Descriptor instead.
+func (*GetResolveJobRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetResolveJobRequest) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *GetResolveJobRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetResolveJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID     string           `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	Status    string           `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Total     int32            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Completed int32            `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Results   []*ResolveResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GetResolveJobResponse) Reset() {
+	*x = GetResolveJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResolveJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResolveJobResponse) ProtoMessage() {}
+
+func (x *GetResolveJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResolveJobResponse.ProtoReflect.Descriptor instead.
+func (*GetResolveJobResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetResolveJobResponse) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *GetResolveJobResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetResolveJobResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetResolveJobResponse) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *GetResolveJobResponse) GetResults() []*ResolveResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ResolvedMapping is a saved mapping from the melon title and artist to the spotify track
+type ResolvedMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MissedTitle   string `protobuf:"bytes,1,opt,name=missed_title,json=missedTitle,proto3" json:"missed_title,omitempty"`
+	MissedArtist  string `protobuf:"bytes,2,opt,name=missed_artist,json=missedArtist,proto3" json:"missed_artist,omitempty"`
+	Title         string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Artist        string `protobuf:"bytes,4,opt,name=artist,proto3" json:"artist,omitempty"`
+	Uri           string `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	Album         string `protobuf:"bytes,6,opt,name=album,proto3" json:"album,omitempty"`
+	DurationMs    int32  `protobuf:"varint,7,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+	Isrc          string `protobuf:"bytes,8,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	Date          string `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`
+	ReportedWrong bool   `protobuf:"varint,10,opt,name=reportedWrong,proto3" json:"reportedWrong,omitempty"`
+}
+
+func (x *ResolvedMapping) Reset() {
+	*x = ResolvedMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolvedMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedMapping) ProtoMessage() {}
+
+func (x *ResolvedMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedMapping.ProtoReflect.Descriptor instead.
+func (*ResolvedMapping) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResolvedMapping) GetMissedTitle() string {
+	if x != nil {
+		return x.MissedTitle
+	}
+	return ""
+}
+
+func (x *ResolvedMapping) GetMissedArtist() string {
+	if x != nil {
+		return x.MissedArtist
+	}
+	return ""
+}
+
+func (x *ResolvedMapping) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ResolvedMapping) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *ResolvedMapping) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ResolvedMapping) GetAlbum() string {
+	if x != nil {
+		return x.Album
+	}
+	return ""
+}
+
+func (x *ResolvedMapping) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ResolvedMapping) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *ResolvedMapping) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ResolvedMapping) GetReportedWrong() bool {
+	if x != nil {
+		return x.ReportedWrong
+	}
+	return false
+}
+
+type ListResolvedTracksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	// Only return the mappings reported as a wrong match by the users
+	ReportedOnly bool `protobuf:"varint,2,opt,name=reportedOnly,proto3" json:"reportedOnly,omitempty"`
+}
+
+func (x *ListResolvedTracksRequest) Reset() {
+	*x = ListResolvedTracksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResolvedTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResolvedTracksRequest) ProtoMessage() {}
+
+func (x *ListResolvedTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetUserPlaylistTracksRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPlaylistTracksRequest) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *GetUserPlaylistTracksRequest) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *GetUserPlaylistTracksRequest) GetTracksEndpoint() string {
-	if x != nil {
-		return x.TracksEndpoint
-	}
-	return ""
-}
-
-type GetUserPlaylistTracksResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PlaylistTracks []*PlaylistTrack `protobuf:"bytes,1,rep,name=playlistTracks,proto3" json:"playlistTracks,omitempty"`
-}
-
-func (x *GetUserPlaylistTracksResponse) Reset() {
-	*x = GetUserPlaylistTracksResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_playlist_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetUserPlaylistTracksResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetUserPlaylistTracksResponse) ProtoMessage() {}
-
-func (x *GetUserPlaylistTracksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlist_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResolvedTracksRequest.ProtoReflect.Descriptor instead.
+func (*ListResolvedTracksRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListResolvedTracksRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ListResolvedTracksRequest) GetReportedOnly() bool {
+	if x != nil {
+		return x.ReportedOnly
+	}
+	return false
+}
+
+type ListResolvedTracksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResolvedTracks []*ResolvedMapping `protobuf:"bytes,1,rep,name=resolvedTracks,proto3" json:"resolvedTracks,omitempty"`
+}
+
+func (x *ListResolvedTracksResponse) Reset() {
+	*x = ListResolvedTracksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResolvedTracksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResolvedTracksResponse) ProtoMessage() {}
+
+func (x *ListResolvedTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResolvedTracksResponse.ProtoReflect.Descriptor instead.
+func (*ListResolvedTracksResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListResolvedTracksResponse) GetResolvedTracks() []*ResolvedMapping {
+	if x != nil {
+		return x.ResolvedTracks
+	}
+	return nil
+}
+
+// UpdateResolvedTrackRequest changes the spotify track of the mapping to the spotifyURI
+type UpdateResolvedTrackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	MissedTitle  string `protobuf:"bytes,2,opt,name=missed_title,json=missedTitle,proto3" json:"missed_title,omitempty"`
+	MissedArtist string `protobuf:"bytes,3,opt,name=missed_artist,json=missedArtist,proto3" json:"missed_artist,omitempty"`
+	SpotifyURI   string `protobuf:"bytes,4,opt,name=spotifyURI,proto3" json:"spotifyURI,omitempty"`
+}
+
+func (x *UpdateResolvedTrackRequest) Reset() {
+	*x = UpdateResolvedTrackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResolvedTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResolvedTrackRequest) ProtoMessage() {}
+
+func (x *UpdateResolvedTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResolvedTrackRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResolvedTrackRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateResolvedTrackRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *UpdateResolvedTrackRequest) GetMissedTitle() string {
+	if x != nil {
+		return x.MissedTitle
+	}
+	return ""
+}
+
+func (x *UpdateResolvedTrackRequest) GetMissedArtist() string {
+	if x != nil {
+		return x.MissedArtist
+	}
+	return ""
+}
+
+func (x *UpdateResolvedTrackRequest) GetSpotifyURI() string {
+	if x != nil {
+		return x.SpotifyURI
+	}
+	return ""
+}
+
+type UpdateResolvedTrackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResolvedTrack *ResolvedMapping `protobuf:"bytes,1,opt,name=resolvedTrack,proto3" json:"resolvedTrack,omitempty"`
+	// Number of saved chart tracks (past dates) changed to the new spotify track
+	TracksUpdated int32 `protobuf:"varint,2,opt,name=tracksUpdated,proto3" json:"tracksUpdated,omitempty"`
+	// Dates (YYYY-MM-DD) already saved with the new spotify track. Their tracks were left unchanged
+	SkippedDates []string `protobuf:"bytes,3,rep,name=skippedDates,proto3" json:"skippedDates,omitempty"`
+}
+
+func (x *UpdateResolvedTrackResponse) Reset() {
+	*x = UpdateResolvedTrackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResolvedTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResolvedTrackResponse) ProtoMessage() {}
+
+func (x *UpdateResolvedTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResolvedTrackResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResolvedTrackResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateResolvedTrackResponse) GetResolvedTrack() *ResolvedMapping {
+	if x != nil {
+		return x.ResolvedTrack
+	}
+	return nil
+}
+
+func (x *UpdateResolvedTrackResponse) GetTracksUpdated() int32 {
+	if x != nil {
+		return x.TracksUpdated
+	}
+	return 0
+}
+
+func (x *UpdateResolvedTrackResponse) GetSkippedDates() []string {
+	if x != nil {
+		return x.SkippedDates
+	}
+	return nil
+}
+
+// DeleteResolvedTrackRequest removes the mapping and moves the saved chart tracks back to the missed tracks
+type DeleteResolvedTrackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	MissedTitle  string `protobuf:"bytes,2,opt,name=missed_title,json=missedTitle,proto3" json:"missed_title,omitempty"`
+	MissedArtist string `protobuf:"bytes,3,opt,name=missed_artist,json=missedArtist,proto3" json:"missed_artist,omitempty"`
+}
+
+func (x *DeleteResolvedTrackRequest) Reset() {
+	*x = DeleteResolvedTrackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteResolvedTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResolvedTrackRequest) ProtoMessage() {}
+
+func (x *DeleteResolvedTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResolvedTrackRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResolvedTrackRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteResolvedTrackRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *DeleteResolvedTrackRequest) GetMissedTitle() string {
+	if x != nil {
+		return x.MissedTitle
+	}
+	return ""
+}
+
+func (x *DeleteResolvedTrackRequest) GetMissedArtist() string {
+	if x != nil {
+		return x.MissedArtist
+	}
+	return ""
+}
+
+type DeleteResolvedTrackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TracksRemoved int32 `protobuf:"varint,1,opt,name=tracksRemoved,proto3" json:"tracksRemoved,omitempty"`
+}
+
+func (x *DeleteResolvedTrackResponse) Reset() {
+	*x = DeleteResolvedTrackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteResolvedTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResolvedTrackResponse) ProtoMessage() {}
+
+func (x *DeleteResolvedTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResolvedTrackResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResolvedTrackResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteResolvedTrackResponse) GetTracksRemoved() int32 {
+	if x != nil {
+		return x.TracksRemoved
+	}
+	return 0
+}
+
+// ReportWrongMatchRequest flags the spotify track in the playlist as a wrong match of the melon chart song
+type ReportWrongMatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	Uri         string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *ReportWrongMatchRequest) Reset() {
+	*x = ReportWrongMatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportWrongMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportWrongMatchRequest) ProtoMessage() {}
+
+func (x *ReportWrongMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportWrongMatchRequest.ProtoReflect.Descriptor instead.
+func (*ReportWrongMatchRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReportWrongMatchRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ReportWrongMatchRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type ReportWrongMatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TracksReported        int32 `protobuf:"varint,1,opt,name=tracksReported,proto3" json:"tracksReported,omitempty"`
+	ResolvedTrackReported bool  `protobuf:"varint,2,opt,name=resolvedTrackReported,proto3" json:"resolvedTrackReported,omitempty"`
+}
+
+func (x *ReportWrongMatchResponse) Reset() {
+	*x = ReportWrongMatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportWrongMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportWrongMatchResponse) ProtoMessage() {}
+
+func (x *ReportWrongMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportWrongMatchResponse.ProtoReflect.Descriptor instead.
+func (*ReportWrongMatchResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReportWrongMatchResponse) GetTracksReported() int32 {
+	if x != nil {
+		return x.TracksReported
+	}
+	return 0
+}
+
+func (x *ReportWrongMatchResponse) GetResolvedTrackReported() bool {
+	if x != nil {
+		return x.ResolvedTrackReported
+	}
+	return false
+}
+
+// ImportResolvedTrackRow is a single mapping from the spreadsheet.
+// accessToken, dryRun and overwrite are only read from the first message of the stream
+type ImportResolvedTrackRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	DryRun      bool   `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// Replace the existing mapping when it's different from the imported one
+	Overwrite    bool   `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	MissedTitle  string `protobuf:"bytes,4,opt,name=missed_title,json=missedTitle,proto3" json:"missed_title,omitempty"`
+	MissedArtist string `protobuf:"bytes,5,opt,name=missed_artist,json=missedArtist,proto3" json:"missed_artist,omitempty"`
+	SpotifyURI   string `protobuf:"bytes,6,opt,name=spotifyURI,proto3" json:"spotifyURI,omitempty"`
+}
+
+func (x *ImportResolvedTrackRow) Reset() {
+	*x = ImportResolvedTrackRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResolvedTrackRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResolvedTrackRow) ProtoMessage() {}
+
+func (x *ImportResolvedTrackRow) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResolvedTrackRow.ProtoReflect.Descriptor instead.
+func (*ImportResolvedTrackRow) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ImportResolvedTrackRow) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ImportResolvedTrackRow) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportResolvedTrackRow) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+func (x *ImportResolvedTrackRow) GetMissedTitle() string {
+	if x != nil {
+		return x.MissedTitle
+	}
+	return ""
+}
+
+func (x *ImportResolvedTrackRow) GetMissedArtist() string {
+	if x != nil {
+		return x.MissedArtist
+	}
+	return ""
+}
+
+func (x *ImportResolvedTrackRow) GetSpotifyURI() string {
+	if x != nil {
+		return x.SpotifyURI
+	}
+	return ""
+}
+
+// ImportResolvedTrackResult is the result of a single imported row
+// status is one of created, updated, unchanged, conflict, invalid and failed
+type ImportResolvedTrackResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row          int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	MissedTitle  string `protobuf:"bytes,2,opt,name=missed_title,json=missedTitle,proto3" json:"missed_title,omitempty"`
+	MissedArtist string `protobuf:"bytes,3,opt,name=missed_artist,json=missedArtist,proto3" json:"missed_artist,omitempty"`
+	SpotifyURI   string `protobuf:"bytes,4,opt,name=spotifyURI,proto3" json:"spotifyURI,omitempty"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Error        string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// Spotify track of the row
+	ResolvedTrack *ResolvedMapping `protobuf:"bytes,7,opt,name=resolvedTrack,proto3" json:"resolvedTrack,omitempty"`
+	// Existing mapping when the row conflicts with it
+	Existing *ResolvedMapping `protobuf:"bytes,8,opt,name=existing,proto3" json:"existing,omitempty"`
+}
+
+func (x *ImportResolvedTrackResult) Reset() {
+	*x = ImportResolvedTrackResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResolvedTrackResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResolvedTrackResult) ProtoMessage() {}
+
+func (x *ImportResolvedTrackResult) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResolvedTrackResult.ProtoReflect.Descriptor instead.
+func (*ImportResolvedTrackResult) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImportResolvedTrackResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportResolvedTrackResult) GetMissedTitle() string {
+	if x != nil {
+		return x.MissedTitle
+	}
+	return ""
+}
+
+func (x *ImportResolvedTrackResult) GetMissedArtist() string {
+	if x != nil {
+		return x.MissedArtist
+	}
+	return ""
+}
+
+func (x *ImportResolvedTrackResult) GetSpotifyURI() string {
+	if x != nil {
+		return x.SpotifyURI
+	}
+	return ""
+}
+
+func (x *ImportResolvedTrackResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportResolvedTrackResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportResolvedTrackResult) GetResolvedTrack() *ResolvedMapping {
+	if x != nil {
+		return x.ResolvedTrack
+	}
+	return nil
+}
+
+func (x *ImportResolvedTrackResult) GetExisting() *ResolvedMapping {
+	if x != nil {
+		return x.Existing
+	}
+	return nil
+}
+
+type ImportResolvedTracksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun    bool                         `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Total     int32                        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Created   int32                        `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated   int32                        `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged int32                        `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Conflicts int32                        `protobuf:"varint,6,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Invalid   int32                        `protobuf:"varint,7,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	Results   []*ImportResolvedTrackResult `protobuf:"bytes,8,rep,name=results,proto3" json:"results,omitempty"`
+	Failed    int32                        `protobuf:"varint,9,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *ImportResolvedTracksResponse) Reset() {
+	*x = ImportResolvedTracksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResolvedTracksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResolvedTracksResponse) ProtoMessage() {}
+
+func (x *ImportResolvedTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResolvedTracksResponse.ProtoReflect.Descriptor instead.
+func (*ImportResolvedTracksResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportResolvedTracksResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportResolvedTracksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportResolvedTracksResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportResolvedTracksResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportResolvedTracksResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportResolvedTracksResponse) GetConflicts() int32 {
+	if x != nil {
+		return x.Conflicts
+	}
+	return 0
+}
+
+func (x *ImportResolvedTracksResponse) GetInvalid() int32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *ImportResolvedTracksResponse) GetResults() []*ImportResolvedTrackResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportResolvedTracksResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ExportResolvedTracksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	// csv or json
+	Format       string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	ReportedOnly bool   `protobuf:"varint,3,opt,name=reportedOnly,proto3" json:"reportedOnly,omitempty"`
+}
+
+func (x *ExportResolvedTracksRequest) Reset() {
+	*x = ExportResolvedTracksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResolvedTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResolvedTracksRequest) ProtoMessage() {}
+
+func (x *ExportResolvedTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResolvedTracksRequest.ProtoReflect.Descriptor instead.
+func (*ExportResolvedTracksRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExportResolvedTracksRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ExportResolvedTracksRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportResolvedTracksRequest) GetReportedOnly() bool {
+	if x != nil {
+		return x.ReportedOnly
+	}
+	return false
+}
+
+type ExportResolvedTracksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format      string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Data        []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Count       int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ExportResolvedTracksResponse) Reset() {
+	*x = ExportResolvedTracksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResolvedTracksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResolvedTracksResponse) ProtoMessage() {}
+
+func (x *ExportResolvedTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResolvedTracksResponse.ProtoReflect.Descriptor instead.
+func (*ExportResolvedTracksResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExportResolvedTracksResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportResolvedTracksResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportResolvedTracksResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportResolvedTracksResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PendingMatch is a chart track matched with a low confidence. It is left out of the playlists until approved
+type PendingMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank            int32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	MelonTitle      string  `protobuf:"bytes,2,opt,name=melon_title,json=melonTitle,proto3" json:"melon_title,omitempty"`
+	MelonArtist     string  `protobuf:"bytes,3,opt,name=melon_artist,json=melonArtist,proto3" json:"melon_artist,omitempty"`
+	Title           string  `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Artist          string  `protobuf:"bytes,5,opt,name=artist,proto3" json:"artist,omitempty"`
+	Uri             string  `protobuf:"bytes,6,opt,name=uri,proto3" json:"uri,omitempty"`
+	Album           string  `protobuf:"bytes,7,opt,name=album,proto3" json:"album,omitempty"`
+	Date            string  `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	MatchConfidence float64 `protobuf:"fixed64,9,opt,name=matchConfidence,proto3" json:"matchConfidence,omitempty"`
+}
+
+func (x *PendingMatch) Reset() {
+	*x = PendingMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingMatch) ProtoMessage() {}
+
+func (x *PendingMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingMatch.ProtoReflect.Descriptor instead.
+func (*PendingMatch) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PendingMatch) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PendingMatch) GetMelonTitle() string {
+	if x != nil {
+		return x.MelonTitle
+	}
+	return ""
+}
+
+func (x *PendingMatch) GetMelonArtist() string {
+	if x != nil {
+		return x.MelonArtist
+	}
+	return ""
+}
+
+func (x *PendingMatch) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PendingMatch) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *PendingMatch) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PendingMatch) GetAlbum() string {
+	if x != nil {
+		return x.Album
+	}
+	return ""
+}
+
+func (x *PendingMatch) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PendingMatch) GetMatchConfidence() float64 {
+	if x != nil {
+		return x.MatchConfidence
+	}
+	return 0
+}
+
+type ListPendingMatchesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	// Optional. Returns the pending matches of every date when empty
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *ListPendingMatchesRequest) Reset() {
+	*x = ListPendingMatchesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingMatchesRequest) ProtoMessage() {}
+
+func (x *ListPendingMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListPendingMatchesRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ListPendingMatchesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ListPendingMatchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingMatches []*PendingMatch `protobuf:"bytes,1,rep,name=pendingMatches,proto3" json:"pendingMatches,omitempty"`
+}
+
+func (x *ListPendingMatchesResponse) Reset() {
+	*x = ListPendingMatchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingMatchesResponse) ProtoMessage() {}
+
+func (x *ListPendingMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListPendingMatchesResponse) GetPendingMatches() []*PendingMatch {
+	if x != nil {
+		return x.PendingMatches
+	}
+	return nil
+}
+
+// ReviewPendingMatchRequest reviews every pending track of the melon title and artist matched to the uri.
+// action is one of approve, reject and replace. spotifyURI is required to replace
+type ReviewPendingMatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	MelonTitle  string `protobuf:"bytes,2,opt,name=melon_title,json=melonTitle,proto3" json:"melon_title,omitempty"`
+	MelonArtist string `protobuf:"bytes,3,opt,name=melon_artist,json=melonArtist,proto3" json:"melon_artist,omitempty"`
+	Uri         string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	Action      string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	SpotifyURI  string `protobuf:"bytes,6,opt,name=spotifyURI,proto3" json:"spotifyURI,omitempty"`
+}
+
+func (x *ReviewPendingMatchRequest) Reset() {
+	*x = ReviewPendingMatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewPendingMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPendingMatchRequest) ProtoMessage() {}
+
+func (x *ReviewPendingMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPendingMatchRequest.ProtoReflect.Descriptor instead.
+func (*ReviewPendingMatchRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReviewPendingMatchRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ReviewPendingMatchRequest) GetMelonTitle() string {
+	if x != nil {
+		return x.MelonTitle
+	}
+	return ""
+}
+
+func (x *ReviewPendingMatchRequest) GetMelonArtist() string {
+	if x != nil {
+		return x.MelonArtist
+	}
+	return ""
+}
+
+func (x *ReviewPendingMatchRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ReviewPendingMatchRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ReviewPendingMatchRequest) GetSpotifyURI() string {
+	if x != nil {
+		return x.SpotifyURI
+	}
+	return ""
+}
+
+type ReviewPendingMatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Number of chart tracks (each date) changed by the review
+	TracksReviewed int32 `protobuf:"varint,2,opt,name=tracksReviewed,proto3" json:"tracksReviewed,omitempty"`
+}
+
+func (x *ReviewPendingMatchResponse) Reset() {
+	*x = ReviewPendingMatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewPendingMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPendingMatchResponse) ProtoMessage() {}
+
+func (x *ReviewPendingMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPendingMatchResponse.ProtoReflect.Descriptor instead.
+func (*ReviewPendingMatchResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReviewPendingMatchResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReviewPendingMatchResponse) GetTracksReviewed() int32 {
+	if x != nil {
+		return x.TracksReviewed
+	}
+	return 0
+}
+
+// PlaylistSubscription keeps the spotify playlist updated with the chart after each daily ingestion
+// policy is one of append_new, mirror and rolling_window
+type PlaylistSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlaylistID string `protobuf:"bytes,2,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	Chart      string `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"`
+	Policy     string `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Number of days of the chart kept in the playlist. Older chart tracks are removed (rolling_window only)
+	WindowDays      int32  `protobuf:"varint,5,opt,name=windowDays,proto3" json:"windowDays,omitempty"`
+	LastAppliedDate string `protobuf:"bytes,6,opt,name=lastAppliedDate,proto3" json:"lastAppliedDate,omitempty"`
+}
+
+func (x *PlaylistSubscription) Reset() {
+	*x = PlaylistSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistSubscription) ProtoMessage() {}
+
+func (x *PlaylistSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistSubscription.ProtoReflect.Descriptor instead.
+func (*PlaylistSubscription) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PlaylistSubscription) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlaylistSubscription) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *PlaylistSubscription) GetChart() string {
+	if x != nil {
+		return x.Chart
+	}
+	return ""
+}
+
+func (x *PlaylistSubscription) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *PlaylistSubscription) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *PlaylistSubscription) GetLastAppliedDate() string {
+	if x != nil {
+		return x.LastAppliedDate
+	}
+	return ""
+}
+
+type CreatePlaylistSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID  string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	// Default is melon_top100
+	Chart      string `protobuf:"bytes,4,opt,name=chart,proto3" json:"chart,omitempty"`
+	Policy     string `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy,omitempty"`
+	WindowDays int32  `protobuf:"varint,6,opt,name=windowDays,proto3" json:"windowDays,omitempty"`
+}
+
+func (x *CreatePlaylistSubscriptionRequest) Reset() {
+	*x = CreatePlaylistSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePlaylistSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaylistSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreatePlaylistSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaylistSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlaylistSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreatePlaylistSubscriptionRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *CreatePlaylistSubscriptionRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreatePlaylistSubscriptionRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *CreatePlaylistSubscriptionRequest) GetChart() string {
+	if x != nil {
+		return x.Chart
+	}
+	return ""
+}
+
+func (x *CreatePlaylistSubscriptionRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *CreatePlaylistSubscriptionRequest) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+type CreatePlaylistSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *PlaylistSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *CreatePlaylistSubscriptionResponse) Reset() {
+	*x = CreatePlaylistSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePlaylistSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaylistSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreatePlaylistSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaylistSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlaylistSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreatePlaylistSubscriptionResponse) GetSubscription() *PlaylistSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type ListPlaylistSubscriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ListPlaylistSubscriptionsRequest) Reset() {
+	*x = ListPlaylistSubscriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlaylistSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListPlaylistSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlaylistSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListPlaylistSubscriptionsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ListPlaylistSubscriptionsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ListPlaylistSubscriptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions []*PlaylistSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *ListPlaylistSubscriptionsResponse) Reset() {
+	*x = ListPlaylistSubscriptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlaylistSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListPlaylistSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlaylistSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListPlaylistSubscriptionsResponse) GetSubscriptions() []*PlaylistSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type DeletePlaylistSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Id          int32  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeletePlaylistSubscriptionRequest) Reset() {
+	*x = DeletePlaylistSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePlaylistSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaylistSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeletePlaylistSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaylistSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlaylistSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeletePlaylistSubscriptionRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *DeletePlaylistSubscriptionRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DeletePlaylistSubscriptionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePlaylistSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *PlaylistSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *DeletePlaylistSubscriptionResponse) Reset() {
+	*x = DeletePlaylistSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePlaylistSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaylistSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeletePlaylistSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaylistSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlaylistSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeletePlaylistSubscriptionResponse) GetSubscription() *PlaylistSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// FavoriteArtist is a spotify artist picked by the user. source is manual, followed or top
+type FavoriteArtist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpotifyArtistID string `protobuf:"bytes,1,opt,name=spotifyArtistID,proto3" json:"spotifyArtistID,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Source          string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *FavoriteArtist) Reset() {
+	*x = FavoriteArtist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteArtist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteArtist) ProtoMessage() {}
+
+func (x *FavoriteArtist) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteArtist.ProtoReflect.Descriptor instead.
+func (*FavoriteArtist) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FavoriteArtist) GetSpotifyArtistID() string {
+	if x != nil {
+		return x.SpotifyArtistID
+	}
+	return ""
+}
+
+func (x *FavoriteArtist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FavoriteArtist) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// AddFavoriteArtistRequest searches the artist name on spotify and adds the first result
+type AddFavoriteArtistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	ArtistName  string `protobuf:"bytes,3,opt,name=artistName,proto3" json:"artistName,omitempty"`
+}
+
+func (x *AddFavoriteArtistRequest) Reset() {
+	*x = AddFavoriteArtistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFavoriteArtistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteArtistRequest) ProtoMessage() {}
+
+func (x *AddFavoriteArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteArtistRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteArtistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddFavoriteArtistRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AddFavoriteArtistRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AddFavoriteArtistRequest) GetArtistName() string {
+	if x != nil {
+		return x.ArtistName
+	}
+	return ""
+}
+
+type AddFavoriteArtistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artist *FavoriteArtist `protobuf:"bytes,1,opt,name=artist,proto3" json:"artist,omitempty"`
+}
+
+func (x *AddFavoriteArtistResponse) Reset() {
+	*x = AddFavoriteArtistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFavoriteArtistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteArtistResponse) ProtoMessage() {}
+
+func (x *AddFavoriteArtistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteArtistResponse.ProtoReflect.Descriptor instead.
+func (*AddFavoriteArtistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AddFavoriteArtistResponse) GetArtist() *FavoriteArtist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+type ListFavoriteArtistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ListFavoriteArtistsRequest) Reset() {
+	*x = ListFavoriteArtistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFavoriteArtistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoriteArtistsRequest) ProtoMessage() {}
+
+func (x *ListFavoriteArtistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoriteArtistsRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoriteArtistsRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListFavoriteArtistsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ListFavoriteArtistsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ListFavoriteArtistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artists []*FavoriteArtist `protobuf:"bytes,1,rep,name=artists,proto3" json:"artists,omitempty"`
+}
+
+func (x *ListFavoriteArtistsResponse) Reset() {
+	*x = ListFavoriteArtistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFavoriteArtistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoriteArtistsResponse) ProtoMessage() {}
+
+func (x *ListFavoriteArtistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoriteArtistsResponse.ProtoReflect.Descriptor instead.
+func (*ListFavoriteArtistsResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListFavoriteArtistsResponse) GetArtists() []*FavoriteArtist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+type RemoveFavoriteArtistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken     string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID          string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	SpotifyArtistID string `protobuf:"bytes,3,opt,name=spotifyArtistID,proto3" json:"spotifyArtistID,omitempty"`
+}
+
+func (x *RemoveFavoriteArtistRequest) Reset() {
+	*x = RemoveFavoriteArtistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFavoriteArtistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteArtistRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteArtistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteArtistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RemoveFavoriteArtistRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RemoveFavoriteArtistRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RemoveFavoriteArtistRequest) GetSpotifyArtistID() string {
+	if x != nil {
+		return x.SpotifyArtistID
+	}
+	return ""
+}
+
+type RemoveFavoriteArtistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artist *FavoriteArtist `protobuf:"bytes,1,opt,name=artist,proto3" json:"artist,omitempty"`
+}
+
+func (x *RemoveFavoriteArtistResponse) Reset() {
+	*x = RemoveFavoriteArtistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFavoriteArtistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteArtistResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteArtistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteArtistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteArtistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RemoveFavoriteArtistResponse) GetArtist() *FavoriteArtist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+// ImportFavoriteArtistsRequest adds the user's followed or top artists on spotify
+type ImportFavoriteArtistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// followed or top
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *ImportFavoriteArtistsRequest) Reset() {
+	*x = ImportFavoriteArtistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportFavoriteArtistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFavoriteArtistsRequest) ProtoMessage() {}
+
+func (x *ImportFavoriteArtistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFavoriteArtistsRequest.ProtoReflect.Descriptor instead.
+func (*ImportFavoriteArtistsRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ImportFavoriteArtistsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ImportFavoriteArtistsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ImportFavoriteArtistsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ImportFavoriteArtistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imported int32             `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Artists  []*FavoriteArtist `protobuf:"bytes,2,rep,name=artists,proto3" json:"artists,omitempty"`
+}
+
+func (x *ImportFavoriteArtistsResponse) Reset() {
+	*x = ImportFavoriteArtistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportFavoriteArtistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFavoriteArtistsResponse) ProtoMessage() {}
+
+func (x *ImportFavoriteArtistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFavoriteArtistsResponse.ProtoReflect.Descriptor instead.
+func (*ImportFavoriteArtistsResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ImportFavoriteArtistsResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportFavoriteArtistsResponse) GetArtists() []*FavoriteArtist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+// CreateFavoriteArtistsPlaylistRequest adds only the chart entries by the user's favorite artists to the playlist
+type CreateFavoriteArtistsPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID  string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	// top100, genre or new_releases
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// Date of the saved top 100 (top100 only)
+	Date string `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	// Melon genre code (genre only)
+	Genre string `protobuf:"bytes,6,opt,name=genre,proto3" json:"genre,omitempty"`
+}
+
+func (x *CreateFavoriteArtistsPlaylistRequest) Reset() {
+	*x = CreateFavoriteArtistsPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFavoriteArtistsPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFavoriteArtistsPlaylistRequest) ProtoMessage() {}
+
+func (x *CreateFavoriteArtistsPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFavoriteArtistsPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*CreateFavoriteArtistsPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateFavoriteArtistsPlaylistRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *CreateFavoriteArtistsPlaylistRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateFavoriteArtistsPlaylistRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *CreateFavoriteArtistsPlaylistRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CreateFavoriteArtistsPlaylistRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CreateFavoriteArtistsPlaylistRequest) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+// For genre and new_releases, the songs are searched on spotify after the response so tracksAdded is 0
+type CreateFavoriteArtistsPlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TracksAdded int32  `protobuf:"varint,2,opt,name=tracksAdded,proto3" json:"tracksAdded,omitempty"`
+}
+
+func (x *CreateFavoriteArtistsPlaylistResponse) Reset() {
+	*x = CreateFavoriteArtistsPlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFavoriteArtistsPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFavoriteArtistsPlaylistResponse) ProtoMessage() {}
+
+func (x *CreateFavoriteArtistsPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFavoriteArtistsPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*CreateFavoriteArtistsPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateFavoriteArtistsPlaylistResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateFavoriteArtistsPlaylistResponse) GetTracksAdded() int32 {
+	if x != nil {
+		return x.TracksAdded
+	}
+	return 0
+}
+
+// ReleaseRadar is the user's weekly playlist of the new releases by the favorite artists
+type ReleaseRadar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaylistID string `protobuf:"bytes,1,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	// Also collect the releases by the artists followed on spotify
+	IncludeFollowed bool   `protobuf:"varint,2,opt,name=includeFollowed,proto3" json:"includeFollowed,omitempty"`
+	LastRunAt       string `protobuf:"bytes,3,opt,name=lastRunAt,proto3" json:"lastRunAt,omitempty"`
+}
+
+func (x *ReleaseRadar) Reset() {
+	*x = ReleaseRadar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseRadar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRadar) ProtoMessage() {}
+
+func (x *ReleaseRadar) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRadar.ProtoReflect.Descriptor instead.
+func (*ReleaseRadar) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReleaseRadar) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *ReleaseRadar) GetIncludeFollowed() bool {
+	if x != nil {
+		return x.IncludeFollowed
+	}
+	return false
+}
+
+func (x *ReleaseRadar) GetLastRunAt() string {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return ""
+}
+
+// EnableReleaseRadarRequest creates a new "Korean Release Radar" playlist when playlistID is empty
+type EnableReleaseRadarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken     string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID          string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID      string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	IncludeFollowed bool   `protobuf:"varint,4,opt,name=includeFollowed,proto3" json:"includeFollowed,omitempty"`
+}
+
+func (x *EnableReleaseRadarRequest) Reset() {
+	*x = EnableReleaseRadarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableReleaseRadarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableReleaseRadarRequest) ProtoMessage() {}
+
+func (x *EnableReleaseRadarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableReleaseRadarRequest.ProtoReflect.Descriptor instead.
+func (*EnableReleaseRadarRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *EnableReleaseRadarRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *EnableReleaseRadarRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *EnableReleaseRadarRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *EnableReleaseRadarRequest) GetIncludeFollowed() bool {
+	if x != nil {
+		return x.IncludeFollowed
+	}
+	return false
+}
+
+type EnableReleaseRadarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReleaseRadar *ReleaseRadar `protobuf:"bytes,1,opt,name=releaseRadar,proto3" json:"releaseRadar,omitempty"`
+}
+
+func (x *EnableReleaseRadarResponse) Reset() {
+	*x = EnableReleaseRadarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableReleaseRadarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableReleaseRadarResponse) ProtoMessage() {}
+
+func (x *EnableReleaseRadarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableReleaseRadarResponse.ProtoReflect.Descriptor instead.
+func (*EnableReleaseRadarResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *EnableReleaseRadarResponse) GetReleaseRadar() *ReleaseRadar {
+	if x != nil {
+		return x.ReleaseRadar
+	}
+	return nil
+}
+
+type GetReleaseRadarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *GetReleaseRadarRequest) Reset() {
+	*x = GetReleaseRadarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReleaseRadarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReleaseRadarRequest) ProtoMessage() {}
+
+func (x *GetReleaseRadarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReleaseRadarRequest.ProtoReflect.Descriptor instead.
+func (*GetReleaseRadarRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetReleaseRadarRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GetReleaseRadarRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetReleaseRadarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReleaseRadar *ReleaseRadar `protobuf:"bytes,1,opt,name=releaseRadar,proto3" json:"releaseRadar,omitempty"`
+}
+
+func (x *GetReleaseRadarResponse) Reset() {
+	*x = GetReleaseRadarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReleaseRadarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReleaseRadarResponse) ProtoMessage() {}
+
+func (x *GetReleaseRadarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReleaseRadarResponse.ProtoReflect.Descriptor instead.
+func (*GetReleaseRadarResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetReleaseRadarResponse) GetReleaseRadar() *ReleaseRadar {
+	if x != nil {
+		return x.ReleaseRadar
+	}
+	return nil
+}
+
+type DisableReleaseRadarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *DisableReleaseRadarRequest) Reset() {
+	*x = DisableReleaseRadarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableReleaseRadarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableReleaseRadarRequest) ProtoMessage() {}
+
+func (x *DisableReleaseRadarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableReleaseRadarRequest.ProtoReflect.Descriptor instead.
+func (*DisableReleaseRadarRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DisableReleaseRadarRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *DisableReleaseRadarRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type DisableReleaseRadarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReleaseRadar *ReleaseRadar `protobuf:"bytes,1,opt,name=releaseRadar,proto3" json:"releaseRadar,omitempty"`
+}
+
+func (x *DisableReleaseRadarResponse) Reset() {
+	*x = DisableReleaseRadarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableReleaseRadarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableReleaseRadarResponse) ProtoMessage() {}
+
+func (x *DisableReleaseRadarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableReleaseRadarResponse.ProtoReflect.Descriptor instead.
+func (*DisableReleaseRadarResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DisableReleaseRadarResponse) GetReleaseRadar() *ReleaseRadar {
+	if x != nil {
+		return x.ReleaseRadar
+	}
+	return nil
+}
+
+// RunReleaseRadarRequest updates the release radar now instead of waiting for the weekly schedule
+type RunReleaseRadarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *RunReleaseRadarRequest) Reset() {
+	*x = RunReleaseRadarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunReleaseRadarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReleaseRadarRequest) ProtoMessage() {}
+
+func (x *RunReleaseRadarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReleaseRadarRequest.ProtoReflect.Descriptor instead.
+func (*RunReleaseRadarRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RunReleaseRadarRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RunReleaseRadarRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type RunReleaseRadarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RunReleaseRadarResponse) Reset() {
+	*x = RunReleaseRadarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunReleaseRadarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReleaseRadarResponse) ProtoMessage() {}
+
+func (x *RunReleaseRadarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReleaseRadarResponse.ProtoReflect.Descriptor instead.
+func (*RunReleaseRadarResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RunReleaseRadarResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// SmartPlaylist is the user's saved rules which are evaluated against the saved charts to fill the spotify playlist.
+// rules is a JSON object. ex) {"fromRank": 1, "toRank": 50, "minDaysOnChart": 7, "maxTracks": 20, "sortBy": "days_on_chart"}
+type SmartPlaylist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PlaylistID      string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	Rules           string `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"`
+	LastEvaluatedAt string `protobuf:"bytes,5,opt,name=lastEvaluatedAt,proto3" json:"lastEvaluatedAt,omitempty"`
+}
+
+func (x *SmartPlaylist) Reset() {
+	*x = SmartPlaylist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SmartPlaylist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmartPlaylist) ProtoMessage() {}
+
+func (x *SmartPlaylist) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmartPlaylist.ProtoReflect.Descriptor instead.
+func (*SmartPlaylist) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SmartPlaylist) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SmartPlaylist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SmartPlaylist) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *SmartPlaylist) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
+}
+
+func (x *SmartPlaylist) GetLastEvaluatedAt() string {
+	if x != nil {
+		return x.LastEvaluatedAt
+	}
+	return ""
+}
+
+type SaveSmartPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// Saving the same name again replaces the rules
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. A new playlist is created on the first evaluation when empty
+	PlaylistID string `protobuf:"bytes,4,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	Rules      string `protobuf:"bytes,5,opt,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *SaveSmartPlaylistRequest) Reset() {
+	*x = SaveSmartPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSmartPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSmartPlaylistRequest) ProtoMessage() {}
+
+func (x *SaveSmartPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSmartPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*SaveSmartPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SaveSmartPlaylistRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *SaveSmartPlaylistRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SaveSmartPlaylistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveSmartPlaylistRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *SaveSmartPlaylistRequest) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
+}
+
+type SaveSmartPlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SmartPlaylist *SmartPlaylist `protobuf:"bytes,1,opt,name=smartPlaylist,proto3" json:"smartPlaylist,omitempty"`
+}
+
+func (x *SaveSmartPlaylistResponse) Reset() {
+	*x = SaveSmartPlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSmartPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSmartPlaylistResponse) ProtoMessage() {}
+
+func (x *SaveSmartPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSmartPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*SaveSmartPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SaveSmartPlaylistResponse) GetSmartPlaylist() *SmartPlaylist {
+	if x != nil {
+		return x.SmartPlaylist
+	}
+	return nil
+}
+
+type ListSmartPlaylistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ListSmartPlaylistsRequest) Reset() {
+	*x = ListSmartPlaylistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSmartPlaylistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSmartPlaylistsRequest) ProtoMessage() {}
+
+func (x *ListSmartPlaylistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSmartPlaylistsRequest.ProtoReflect.Descriptor instead.
+func (*ListSmartPlaylistsRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListSmartPlaylistsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ListSmartPlaylistsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ListSmartPlaylistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SmartPlaylists []*SmartPlaylist `protobuf:"bytes,1,rep,name=smartPlaylists,proto3" json:"smartPlaylists,omitempty"`
+}
+
+func (x *ListSmartPlaylistsResponse) Reset() {
+	*x = ListSmartPlaylistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSmartPlaylistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSmartPlaylistsResponse) ProtoMessage() {}
+
+func (x *ListSmartPlaylistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSmartPlaylistsResponse.ProtoReflect.Descriptor instead.
+func (*ListSmartPlaylistsResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListSmartPlaylistsResponse) GetSmartPlaylists() []*SmartPlaylist {
+	if x != nil {
+		return x.SmartPlaylists
+	}
+	return nil
+}
+
+type DeleteSmartPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Id          int32  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteSmartPlaylistRequest) Reset() {
+	*x = DeleteSmartPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSmartPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSmartPlaylistRequest) ProtoMessage() {}
+
+func (x *DeleteSmartPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSmartPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSmartPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteSmartPlaylistRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *DeleteSmartPlaylistRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DeleteSmartPlaylistRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSmartPlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SmartPlaylist *SmartPlaylist `protobuf:"bytes,1,opt,name=smartPlaylist,proto3" json:"smartPlaylist,omitempty"`
+}
+
+func (x *DeleteSmartPlaylistResponse) Reset() {
+	*x = DeleteSmartPlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSmartPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSmartPlaylistResponse) ProtoMessage() {}
+
+func (x *DeleteSmartPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSmartPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSmartPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteSmartPlaylistResponse) GetSmartPlaylist() *SmartPlaylist {
+	if x != nil {
+		return x.SmartPlaylist
+	}
+	return nil
+}
+
+type EvaluateSmartPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Id          int32  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional chart date (yyyy-mm-dd). Defaults to the rules' date or the latest saved chart
+	Date string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// Only return the matched tracks without writing them to the playlist
+	DryRun bool `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *EvaluateSmartPlaylistRequest) Reset() {
+	*x = EvaluateSmartPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateSmartPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateSmartPlaylistRequest) ProtoMessage() {}
+
+func (x *EvaluateSmartPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateSmartPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateSmartPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *EvaluateSmartPlaylistRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *EvaluateSmartPlaylistRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *EvaluateSmartPlaylistRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EvaluateSmartPlaylistRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *EvaluateSmartPlaylistRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SmartPlaylistTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank           int32  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Title          string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Artist         string `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
+	Uri            string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	DaysOnChart    int32  `protobuf:"varint,5,opt,name=daysOnChart,proto3" json:"daysOnChart,omitempty"`
+	FirstChartDate string `protobuf:"bytes,6,opt,name=firstChartDate,proto3" json:"firstChartDate,omitempty"`
+}
+
+func (x *SmartPlaylistTrack) Reset() {
+	*x = SmartPlaylistTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SmartPlaylistTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmartPlaylistTrack) ProtoMessage() {}
+
+func (x *SmartPlaylistTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmartPlaylistTrack.ProtoReflect.Descriptor instead.
+func (*SmartPlaylistTrack) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SmartPlaylistTrack) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SmartPlaylistTrack) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SmartPlaylistTrack) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *SmartPlaylistTrack) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *SmartPlaylistTrack) GetDaysOnChart() int32 {
+	if x != nil {
+		return x.DaysOnChart
+	}
+	return 0
+}
+
+func (x *SmartPlaylistTrack) GetFirstChartDate() string {
+	if x != nil {
+		return x.FirstChartDate
+	}
+	return ""
+}
+
+type EvaluateSmartPlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     string                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PlaylistID string                `protobuf:"bytes,2,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	Date       string                `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Tracks     []*SmartPlaylistTrack `protobuf:"bytes,4,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	SnapshotID string                `protobuf:"bytes,5,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+}
+
+func (x *EvaluateSmartPlaylistResponse) Reset() {
+	*x = EvaluateSmartPlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateSmartPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateSmartPlaylistResponse) ProtoMessage() {}
+
+func (x *EvaluateSmartPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateSmartPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateSmartPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *EvaluateSmartPlaylistResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EvaluateSmartPlaylistResponse) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *EvaluateSmartPlaylistResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *EvaluateSmartPlaylistResponse) GetTracks() []*SmartPlaylistTrack {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *EvaluateSmartPlaylistResponse) GetSnapshotID() string {
+	if x != nil {
+		return x.SnapshotID
+	}
+	return ""
+}
+
+// PlaylistSnapshot is the playlist right before melongo wrote to it
+type PlaylistSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlaylistID        string   `protobuf:"bytes,2,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	SpotifySnapshotID string   `protobuf:"bytes,3,opt,name=spotifySnapshotID,proto3" json:"spotifySnapshotID,omitempty"`
+	Uris              []string `protobuf:"bytes,4,rep,name=uris,proto3" json:"uris,omitempty"`
+	// Write that triggered the snapshot. ex) melon_top100, subscription_mirror, restore
+	Operation string `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *PlaylistSnapshot) Reset() {
+	*x = PlaylistSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistSnapshot) ProtoMessage() {}
+
+func (x *PlaylistSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistSnapshot.ProtoReflect.Descriptor instead.
+func (*PlaylistSnapshot) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PlaylistSnapshot) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlaylistSnapshot) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *PlaylistSnapshot) GetSpotifySnapshotID() string {
+	if x != nil {
+		return x.SpotifySnapshotID
+	}
+	return ""
+}
+
+func (x *PlaylistSnapshot) GetUris() []string {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+func (x *PlaylistSnapshot) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *PlaylistSnapshot) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListPlaylistSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID  string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+}
+
+func (x *ListPlaylistSnapshotsRequest) Reset() {
+	*x = ListPlaylistSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlaylistSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListPlaylistSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlaylistSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListPlaylistSnapshotsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ListPlaylistSnapshotsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ListPlaylistSnapshotsRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+type ListPlaylistSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*PlaylistSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *ListPlaylistSnapshotsResponse) Reset() {
+	*x = ListPlaylistSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlaylistSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListPlaylistSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlaylistSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListPlaylistSnapshotsResponse) GetSnapshots() []*PlaylistSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type RestorePlaylistSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Id          int32  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestorePlaylistSnapshotRequest) Reset() {
+	*x = RestorePlaylistSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestorePlaylistSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePlaylistSnapshotRequest) ProtoMessage() {}
+
+func (x *RestorePlaylistSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePlaylistSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestorePlaylistSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RestorePlaylistSnapshotRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RestorePlaylistSnapshotRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RestorePlaylistSnapshotRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestorePlaylistSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RestoredTracks int32  `protobuf:"varint,2,opt,name=restoredTracks,proto3" json:"restoredTracks,omitempty"`
+	// Snapshot ID of the playlist after the restore
+	SpotifySnapshotID string `protobuf:"bytes,3,opt,name=spotifySnapshotID,proto3" json:"spotifySnapshotID,omitempty"`
+	// Snapshot of the playlist taken right before the restore so that the restore can be reverted too
+	BackupSnapshotID int32 `protobuf:"varint,4,opt,name=backupSnapshotID,proto3" json:"backupSnapshotID,omitempty"`
+}
+
+func (x *RestorePlaylistSnapshotResponse) Reset() {
+	*x = RestorePlaylistSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestorePlaylistSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePlaylistSnapshotResponse) ProtoMessage() {}
+
+func (x *RestorePlaylistSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePlaylistSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestorePlaylistSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RestorePlaylistSnapshotResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RestorePlaylistSnapshotResponse) GetRestoredTracks() int32 {
+	if x != nil {
+		return x.RestoredTracks
+	}
+	return 0
+}
+
+func (x *RestorePlaylistSnapshotResponse) GetSpotifySnapshotID() string {
+	if x != nil {
+		return x.SpotifySnapshotID
+	}
+	return ""
+}
+
+func (x *RestorePlaylistSnapshotResponse) GetBackupSnapshotID() int32 {
+	if x != nil {
+		return x.BackupSnapshotID
+	}
+	return 0
+}
+
+// PlaylistOperation is the tracks added to the playlist by melongo at their positions
+type PlaylistOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlaylistID string   `protobuf:"bytes,2,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	Operation  string   `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Uris       []string `protobuf:"bytes,4,rep,name=uris,proto3" json:"uris,omitempty"`
+	Positions  []int32  `protobuf:"varint,5,rep,packed,name=positions,proto3" json:"positions,omitempty"`
+	// Snapshot ID of the playlist right after the tracks were added
+	SpotifySnapshotID string `protobuf:"bytes,6,opt,name=spotifySnapshotID,proto3" json:"spotifySnapshotID,omitempty"`
+	CreatedAt         string `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UndoneAt          string `protobuf:"bytes,8,opt,name=undoneAt,proto3" json:"undoneAt,omitempty"`
+}
+
+func (x *PlaylistOperation) Reset() {
+	*x = PlaylistOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistOperation) ProtoMessage() {}
+
+func (x *PlaylistOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistOperation.ProtoReflect.Descriptor instead.
+func (*PlaylistOperation) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PlaylistOperation) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlaylistOperation) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *PlaylistOperation) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *PlaylistOperation) GetUris() []string {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+func (x *PlaylistOperation) GetPositions() []int32 {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *PlaylistOperation) GetSpotifySnapshotID() string {
+	if x != nil {
+		return x.SpotifySnapshotID
+	}
+	return ""
+}
+
+func (x *PlaylistOperation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PlaylistOperation) GetUndoneAt() string {
+	if x != nil {
+		return x.UndoneAt
+	}
+	return ""
+}
+
+type UndoOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// 0 undoes the user's last operation which isn't undone yet
+	Id int32 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UndoOperationRequest) Reset() {
+	*x = UndoOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoOperationRequest) ProtoMessage() {}
+
+func (x *UndoOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoOperationRequest.ProtoReflect.Descriptor instead.
+func (*UndoOperationRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UndoOperationRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *UndoOperationRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UndoOperationRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UndoOperationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    string             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Operation *PlaylistOperation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Snapshot ID of the playlist after the tracks were removed
+	SpotifySnapshotID string `protobuf:"bytes,3,opt,name=spotifySnapshotID,proto3" json:"spotifySnapshotID,omitempty"`
+}
+
+func (x *UndoOperationResponse) Reset() {
+	*x = UndoOperationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoOperationResponse) ProtoMessage() {}
+
+func (x *UndoOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoOperationResponse.ProtoReflect.Descriptor instead.
+func (*UndoOperationResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UndoOperationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UndoOperationResponse) GetOperation() *PlaylistOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *UndoOperationResponse) GetSpotifySnapshotID() string {
+	if x != nil {
+		return x.SpotifySnapshotID
+	}
+	return ""
+}
+
+type ExportPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID  string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	// csv, json, m3u (extended M3U) or xspf
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ExportPlaylistRequest) Reset() {
+	*x = ExportPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPlaylistRequest) ProtoMessage() {}
+
+func (x *ExportPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*ExportPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ExportPlaylistRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ExportPlaylistRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ExportPlaylistRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *ExportPlaylistRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// ExportPlaylistChunk is a part of the exported file streamed page by page.
+// contentType and fileName are only set on the first chunk
+type ExportPlaylistChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType string `protobuf:"bytes,1,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	FileName    string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	Data        []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportPlaylistChunk) Reset() {
+	*x = ExportPlaylistChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPlaylistChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPlaylistChunk) ProtoMessage() {}
+
+func (x *ExportPlaylistChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPlaylistChunk.ProtoReflect.Descriptor instead.
+func (*ExportPlaylistChunk) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ExportPlaylistChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportPlaylistChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportPlaylistChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ImportTrackListLine is a single line of the uploaded track list, either "artist - title" or a CSV row.
+// accessToken, userID, playlistID, playlistName and dryRun are only read from the first message of the stream
+type ImportTrackListLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// Tracks are added to the playlist. A new playlist named playlistName is created when it's empty
+	PlaylistID   string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	PlaylistName string `protobuf:"bytes,4,opt,name=playlistName,proto3" json:"playlistName,omitempty"`
+	DryRun       bool   `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Line         string `protobuf:"bytes,6,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *ImportTrackListLine) Reset() {
+	*x = ImportTrackListLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTrackListLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTrackListLine) ProtoMessage() {}
+
+func (x *ImportTrackListLine) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTrackListLine.ProtoReflect.Descriptor instead.
+func (*ImportTrackListLine) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ImportTrackListLine) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ImportTrackListLine) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ImportTrackListLine) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *ImportTrackListLine) GetPlaylistName() string {
+	if x != nil {
+		return x.PlaylistName
+	}
+	return ""
+}
+
+func (x *ImportTrackListLine) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportTrackListLine) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+// ImportTrackListResult is the result of a single line
+// status is one of matched, resolved, low_confidence, unmatched, duplicate and invalid
+type ImportTrackListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line       int32   `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Text       string  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Title      string  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Artist     string  `protobuf:"bytes,4,opt,name=artist,proto3" json:"artist,omitempty"`
+	Status     string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Uri        string  `protobuf:"bytes,6,opt,name=uri,proto3" json:"uri,omitempty"`
+	Confidence float64 `protobuf:"fixed64,7,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Error      string  `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// Best spotify track of the low confidence match
+	CandidateTitle  string `protobuf:"bytes,9,opt,name=candidateTitle,proto3" json:"candidateTitle,omitempty"`
+	CandidateArtist string `protobuf:"bytes,10,opt,name=candidateArtist,proto3" json:"candidateArtist,omitempty"`
+}
+
+func (x *ImportTrackListResult) Reset() {
+	*x = ImportTrackListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTrackListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTrackListResult) ProtoMessage() {}
+
+func (x *ImportTrackListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTrackListResult.ProtoReflect.Descriptor instead.
+func (*ImportTrackListResult) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ImportTrackListResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportTrackListResult) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ImportTrackListResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportTrackListResult) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *ImportTrackListResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportTrackListResult) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ImportTrackListResult) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *ImportTrackListResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportTrackListResult) GetCandidateTitle() string {
+	if x != nil {
+		return x.CandidateTitle
+	}
+	return ""
+}
+
+func (x *ImportTrackListResult) GetCandidateArtist() string {
+	if x != nil {
+		return x.CandidateArtist
+	}
+	return ""
+}
+
+type ImportTrackListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun      bool   `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	PlaylistID  string `protobuf:"bytes,2,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	ExternalUrl string `protobuf:"bytes,3,opt,name=externalUrl,proto3" json:"externalUrl,omitempty"`
+	Total       int32  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Matched     int32  `protobuf:"varint,5,opt,name=matched,proto3" json:"matched,omitempty"`
+	Unmatched   int32  `protobuf:"varint,6,opt,name=unmatched,proto3" json:"unmatched,omitempty"`
+	Added       int32  `protobuf:"varint,7,opt,name=added,proto3" json:"added,omitempty"`
+	OperationID int32  `protobuf:"varint,8,opt,name=operationID,proto3" json:"operationID,omitempty"`
+	// Lines which couldn't be added to the playlist
+	UnmatchedLines []*ImportTrackListResult `protobuf:"bytes,9,rep,name=unmatchedLines,proto3" json:"unmatchedLines,omitempty"`
+	Status         string                   `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ImportTrackListResponse) Reset() {
+	*x = ImportTrackListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTrackListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTrackListResponse) ProtoMessage() {}
+
+func (x *ImportTrackListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTrackListResponse.ProtoReflect.Descriptor instead.
+func (*ImportTrackListResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ImportTrackListResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportTrackListResponse) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *ImportTrackListResponse) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+func (x *ImportTrackListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportTrackListResponse) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ImportTrackListResponse) GetUnmatched() int32 {
+	if x != nil {
+		return x.Unmatched
+	}
+	return 0
+}
+
+func (x *ImportTrackListResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *ImportTrackListResponse) GetOperationID() int32 {
+	if x != nil {
+		return x.OperationID
+	}
+	return 0
+}
+
+func (x *ImportTrackListResponse) GetUnmatchedLines() []*ImportTrackListResult {
+	if x != nil {
+		return x.UnmatchedLines
+	}
+	return nil
+}
+
+func (x *ImportTrackListResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type MergePlaylistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	// Spotify IDs of the playlists to merge, in the order their tracks are added
+	PlaylistIDs []string `protobuf:"bytes,3,rep,name=playlistIDs,proto3" json:"playlistIDs,omitempty"`
+	// union (default) keeps the tracks of any playlist, intersection only the tracks in every playlist
+	Mode string `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// uri (default) or isrc. isrc also removes the same recording released on different albums
+	DedupeBy string `protobuf:"bytes,5,opt,name=dedupeBy,proto3" json:"dedupeBy,omitempty"`
+	// Existing playlist to write to. A new playlist named targetName is created when it's empty
+	TargetPlaylistID string `protobuf:"bytes,6,opt,name=targetPlaylistID,proto3" json:"targetPlaylistID,omitempty"`
+	TargetName       string `protobuf:"bytes,7,opt,name=targetName,proto3" json:"targetName,omitempty"`
+	// Replace the tracks of the existing target playlist instead of appending the missing ones
+	Replace bool `protobuf:"varint,8,opt,name=replace,proto3" json:"replace,omitempty"`
+	DryRun  bool `protobuf:"varint,9,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *MergePlaylistsRequest) Reset() {
+	*x = MergePlaylistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergePlaylistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePlaylistsRequest) ProtoMessage() {}
+
+func (x *MergePlaylistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePlaylistsRequest.ProtoReflect.Descriptor instead.
+func (*MergePlaylistsRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *MergePlaylistsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *MergePlaylistsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MergePlaylistsRequest) GetPlaylistIDs() []string {
+	if x != nil {
+		return x.PlaylistIDs
+	}
+	return nil
+}
+
+func (x *MergePlaylistsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MergePlaylistsRequest) GetDedupeBy() string {
+	if x != nil {
+		return x.DedupeBy
+	}
+	return ""
+}
+
+func (x *MergePlaylistsRequest) GetTargetPlaylistID() string {
+	if x != nil {
+		return x.TargetPlaylistID
+	}
+	return ""
+}
+
+func (x *MergePlaylistsRequest) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *MergePlaylistsRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+func (x *MergePlaylistsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type MergePlaylistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PlaylistID  string `protobuf:"bytes,2,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	ExternalUrl string `protobuf:"bytes,3,opt,name=externalUrl,proto3" json:"externalUrl,omitempty"`
+	// Tracks read from every playlist
+	Total int32 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// Merged tracks without the duplicates
+	Uris              []string `protobuf:"bytes,5,rep,name=uris,proto3" json:"uris,omitempty"`
+	DuplicatesRemoved int32    `protobuf:"varint,6,opt,name=duplicatesRemoved,proto3" json:"duplicatesRemoved,omitempty"`
+	// Tracks written to the target playlist
+	Added      int32  `protobuf:"varint,7,opt,name=added,proto3" json:"added,omitempty"`
+	SnapshotID string `protobuf:"bytes,8,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+	// Operation which can be undone when the tracks were appended
+	OperationID int32 `protobuf:"varint,9,opt,name=operationID,proto3" json:"operationID,omitempty"`
+}
+
+func (x *MergePlaylistsResponse) Reset() {
+	*x = MergePlaylistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergePlaylistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePlaylistsResponse) ProtoMessage() {}
+
+func (x *MergePlaylistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePlaylistsResponse.ProtoReflect.Descriptor instead.
+func (*MergePlaylistsResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MergePlaylistsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MergePlaylistsResponse) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *MergePlaylistsResponse) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+func (x *MergePlaylistsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MergePlaylistsResponse) GetUris() []string {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+func (x *MergePlaylistsResponse) GetDuplicatesRemoved() int32 {
+	if x != nil {
+		return x.DuplicatesRemoved
+	}
+	return 0
+}
+
+func (x *MergePlaylistsResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *MergePlaylistsResponse) GetSnapshotID() string {
+	if x != nil {
+		return x.SnapshotID
+	}
+	return ""
+}
+
+func (x *MergePlaylistsResponse) GetOperationID() int32 {
+	if x != nil {
+		return x.OperationID
+	}
+	return 0
+}
+
+type DedupePlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID  string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	// uri (default) or isrc
+	DedupeBy string `protobuf:"bytes,4,opt,name=dedupeBy,proto3" json:"dedupeBy,omitempty"`
+	DryRun   bool   `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *DedupePlaylistRequest) Reset() {
+	*x = DedupePlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DedupePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DedupePlaylistRequest) ProtoMessage() {}
+
+func (x *DedupePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DedupePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*DedupePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DedupePlaylistRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *DedupePlaylistRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DedupePlaylistRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *DedupePlaylistRequest) GetDedupeBy() string {
+	if x != nil {
+		return x.DedupeBy
+	}
+	return ""
+}
+
+func (x *DedupePlaylistRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// DuplicateTrack is a track removed from the playlist since the same track is at keptPosition
+type DuplicateTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri          string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Title        string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Artist       string `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
+	Position     int32  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	KeptPosition int32  `protobuf:"varint,5,opt,name=keptPosition,proto3" json:"keptPosition,omitempty"`
+}
+
+func (x *DuplicateTrack) Reset() {
+	*x = DuplicateTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DuplicateTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateTrack) ProtoMessage() {}
+
+func (x *DuplicateTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateTrack.ProtoReflect.Descriptor instead.
+func (*DuplicateTrack) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DuplicateTrack) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DuplicateTrack) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DuplicateTrack) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *DuplicateTrack) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *DuplicateTrack) GetKeptPosition() int32 {
+	if x != nil {
+		return x.KeptPosition
+	}
+	return 0
+}
+
+type DedupePlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     string            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Duplicates []*DuplicateTrack `protobuf:"bytes,2,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Removed    int32             `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	// Snapshot saved before the duplicates were removed (restore it to bring them back)
+	BackupSnapshotID  int32  `protobuf:"varint,4,opt,name=backupSnapshotID,proto3" json:"backupSnapshotID,omitempty"`
+	SpotifySnapshotID string `protobuf:"bytes,5,opt,name=spotifySnapshotID,proto3" json:"spotifySnapshotID,omitempty"`
+}
+
+func (x *DedupePlaylistResponse) Reset() {
+	*x = DedupePlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DedupePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DedupePlaylistResponse) ProtoMessage() {}
+
+func (x *DedupePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DedupePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*DedupePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DedupePlaylistResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DedupePlaylistResponse) GetDuplicates() []*DuplicateTrack {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+func (x *DedupePlaylistResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *DedupePlaylistResponse) GetBackupSnapshotID() int32 {
+	if x != nil {
+		return x.BackupSnapshotID
+	}
+	return 0
+}
+
+func (x *DedupePlaylistResponse) GetSpotifySnapshotID() string {
+	if x != nil {
+		return x.SpotifySnapshotID
+	}
+	return ""
+}
+
+type GetPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID  string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+}
+
+func (x *GetPlaylistRequest) Reset() {
+	*x = GetPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaylistRequest) ProtoMessage() {}
+
+func (x *GetPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetPlaylistRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GetPlaylistRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetPlaylistRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+// PlaylistDetailTrack is a track of the playlist at its position
+type PlaylistDetailTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position      int32  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Title         string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Artist        string `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
+	Album         string `protobuf:"bytes,4,opt,name=album,proto3" json:"album,omitempty"`
+	AlbumImageUrl string `protobuf:"bytes,5,opt,name=albumImageUrl,proto3" json:"albumImageUrl,omitempty"`
+	Uri           string `protobuf:"bytes,6,opt,name=uri,proto3" json:"uri,omitempty"`
+	DurationMs    int32  `protobuf:"varint,7,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+	Popularity    int32  `protobuf:"varint,8,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	AddedAt       string `protobuf:"bytes,9,opt,name=addedAt,proto3" json:"addedAt,omitempty"`
+	IsLocal       bool   `protobuf:"varint,10,opt,name=isLocal,proto3" json:"isLocal,omitempty"`
+	// false when the track was removed from spotify or can't be played in the user's country
+	Available bool `protobuf:"varint,11,opt,name=available,proto3" json:"available,omitempty"`
+}
+
+func (x *PlaylistDetailTrack) Reset() {
+	*x = PlaylistDetailTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistDetailTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistDetailTrack) ProtoMessage() {}
+
+func (x *PlaylistDetailTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistDetailTrack.ProtoReflect.Descriptor instead.
+func (*PlaylistDetailTrack) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PlaylistDetailTrack) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PlaylistDetailTrack) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlaylistDetailTrack) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *PlaylistDetailTrack) GetAlbum() string {
+	if x != nil {
+		return x.Album
+	}
+	return ""
+}
+
+func (x *PlaylistDetailTrack) GetAlbumImageUrl() string {
+	if x != nil {
+		return x.AlbumImageUrl
+	}
+	return ""
+}
+
+func (x *PlaylistDetailTrack) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PlaylistDetailTrack) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *PlaylistDetailTrack) GetPopularity() int32 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+func (x *PlaylistDetailTrack) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+func (x *PlaylistDetailTrack) GetIsLocal() bool {
+	if x != nil {
+		return x.IsLocal
+	}
+	return false
+}
+
+func (x *PlaylistDetailTrack) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type PlaylistDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpotifyPlaylistID string                 `protobuf:"bytes,1,opt,name=spotifyPlaylistID,proto3" json:"spotifyPlaylistID,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl          string                 `protobuf:"bytes,4,opt,name=imageUrl,proto3" json:"imageUrl,omitempty"`
+	ExternalUrl       string                 `protobuf:"bytes,5,opt,name=externalUrl,proto3" json:"externalUrl,omitempty"`
+	OwnerID           string                 `protobuf:"bytes,6,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
+	OwnerName         string                 `protobuf:"bytes,7,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	Followers         int32                  `protobuf:"varint,8,opt,name=followers,proto3" json:"followers,omitempty"`
+	Public            bool                   `protobuf:"varint,9,opt,name=public,proto3" json:"public,omitempty"`
+	Collaborative     bool                   `protobuf:"varint,10,opt,name=collaborative,proto3" json:"collaborative,omitempty"`
+	SnapshotID        string                 `protobuf:"bytes,11,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+	TotalTracks       int32                  `protobuf:"varint,12,opt,name=totalTracks,proto3" json:"totalTracks,omitempty"`
+	Tracks            []*PlaylistDetailTrack `protobuf:"bytes,13,rep,name=tracks,proto3" json:"tracks,omitempty"`
+}
+
+func (x *PlaylistDetail) Reset() {
+	*x = PlaylistDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistDetail) ProtoMessage() {}
+
+func (x *PlaylistDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistDetail.ProtoReflect.Descriptor instead.
+func (*PlaylistDetail) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PlaylistDetail) GetSpotifyPlaylistID() string {
+	if x != nil {
+		return x.SpotifyPlaylistID
+	}
+	return ""
+}
+
+func (x *PlaylistDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlaylistDetail) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlaylistDetail) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *PlaylistDetail) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+func (x *PlaylistDetail) GetOwnerID() string {
+	if x != nil {
+		return x.OwnerID
+	}
+	return ""
+}
+
+func (x *PlaylistDetail) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *PlaylistDetail) GetFollowers() int32 {
+	if x != nil {
+		return x.Followers
+	}
+	return 0
+}
+
+func (x *PlaylistDetail) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *PlaylistDetail) GetCollaborative() bool {
+	if x != nil {
+		return x.Collaborative
+	}
+	return false
+}
+
+func (x *PlaylistDetail) GetSnapshotID() string {
+	if x != nil {
+		return x.SnapshotID
+	}
+	return ""
+}
+
+func (x *PlaylistDetail) GetTotalTracks() int32 {
+	if x != nil {
+		return x.TotalTracks
+	}
+	return 0
+}
+
+func (x *PlaylistDetail) GetTracks() []*PlaylistDetailTrack {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+type GetPlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Playlist *PlaylistDetail `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+}
+
+func (x *GetPlaylistResponse) Reset() {
+	*x = GetPlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaylistResponse) ProtoMessage() {}
+
+func (x *GetPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetPlaylistResponse) GetPlaylist() *PlaylistDetail {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+// UpdatePlaylistDetailsRequest changes only the given details of the playlist
+type UpdatePlaylistDetailsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID  string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	Name        string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Set the description to empty instead of leaving it unchanged
+	ClearDescription bool `protobuf:"varint,6,opt,name=clearDescription,proto3" json:"clearDescription,omitempty"`
+	// public, private or collaborative. Empty leaves it unchanged
+	Visibility string `protobuf:"bytes,7,opt,name=visibility,proto3" json:"visibility,omitempty"`
+}
+
+func (x *UpdatePlaylistDetailsRequest) Reset() {
+	*x = UpdatePlaylistDetailsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePlaylistDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaylistDetailsRequest) ProtoMessage() {}
+
+func (x *UpdatePlaylistDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaylistDetailsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlaylistDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *UpdatePlaylistDetailsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistDetailsRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistDetailsRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistDetailsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistDetailsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistDetailsRequest) GetClearDescription() bool {
+	if x != nil {
+		return x.ClearDescription
+	}
+	return false
+}
+
+func (x *UpdatePlaylistDetailsRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type UpdatePlaylistDetailsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UpdatePlaylistDetailsResponse) Reset() {
+	*x = UpdatePlaylistDetailsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePlaylistDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaylistDetailsResponse) ProtoMessage() {}
+
+func (x *UpdatePlaylistDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaylistDetailsResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlaylistDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UpdatePlaylistDetailsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// PlaylistTrackPosition is the track at the position of the playlist
+type PlaylistTrackPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri      string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Position int32  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *PlaylistTrackPosition) Reset() {
+	*x = PlaylistTrackPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistTrackPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistTrackPosition) ProtoMessage() {}
+
+func (x *PlaylistTrackPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistTrackPosition.ProtoReflect.Descriptor instead.
+func (*PlaylistTrackPosition) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PlaylistTrackPosition) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PlaylistTrackPosition) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// RemovePlaylistTracksRequest removes every occurrence of uris and the tracks only at their positions
+type RemovePlaylistTracksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string                   `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string                   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID  string                   `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	Uris        []string                 `protobuf:"bytes,4,rep,name=uris,proto3" json:"uris,omitempty"`
+	Tracks      []*PlaylistTrackPosition `protobuf:"bytes,5,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	// Snapshot the positions are of. The current playlist when empty
+	SnapshotID string `protobuf:"bytes,6,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+}
+
+func (x *RemovePlaylistTracksRequest) Reset() {
+	*x = RemovePlaylistTracksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemovePlaylistTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePlaylistTracksRequest) ProtoMessage() {}
+
+func (x *RemovePlaylistTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePlaylistTracksRequest.ProtoReflect.Descriptor instead.
+func (*RemovePlaylistTracksRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RemovePlaylistTracksRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RemovePlaylistTracksRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RemovePlaylistTracksRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *RemovePlaylistTracksRequest) GetUris() []string {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+func (x *RemovePlaylistTracksRequest) GetTracks() []*PlaylistTrackPosition {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *RemovePlaylistTracksRequest) GetSnapshotID() string {
+	if x != nil {
+		return x.SnapshotID
+	}
+	return ""
+}
+
+type RemovePlaylistTracksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Snapshot saved before the tracks were removed (restore it to bring them back)
+	BackupSnapshotID  int32  `protobuf:"varint,2,opt,name=backupSnapshotID,proto3" json:"backupSnapshotID,omitempty"`
+	SpotifySnapshotID string `protobuf:"bytes,3,opt,name=spotifySnapshotID,proto3" json:"spotifySnapshotID,omitempty"`
+}
+
+func (x *RemovePlaylistTracksResponse) Reset() {
+	*x = RemovePlaylistTracksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemovePlaylistTracksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePlaylistTracksResponse) ProtoMessage() {}
+
+func (x *RemovePlaylistTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePlaylistTracksResponse.ProtoReflect.Descriptor instead.
+func (*RemovePlaylistTracksResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *RemovePlaylistTracksResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RemovePlaylistTracksResponse) GetBackupSnapshotID() int32 {
+	if x != nil {
+		return x.BackupSnapshotID
+	}
+	return 0
+}
+
+func (x *RemovePlaylistTracksResponse) GetSpotifySnapshotID() string {
+	if x != nil {
+		return x.SpotifySnapshotID
+	}
+	return ""
+}
+
+// ReorderPlaylistTracksRequest moves rangeLength tracks from rangeStart to right before insertBefore
+type ReorderPlaylistTracksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID       string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID   string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+	RangeStart   int32  `protobuf:"varint,4,opt,name=rangeStart,proto3" json:"rangeStart,omitempty"`
+	InsertBefore int32  `protobuf:"varint,5,opt,name=insertBefore,proto3" json:"insertBefore,omitempty"`
+	// 1 when it's 0
+	RangeLength int32 `protobuf:"varint,6,opt,name=rangeLength,proto3" json:"rangeLength,omitempty"`
+	// Snapshot the positions are of. The current playlist when empty
+	SnapshotID string `protobuf:"bytes,7,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+}
+
+func (x *ReorderPlaylistTracksRequest) Reset() {
+	*x = ReorderPlaylistTracksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderPlaylistTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPlaylistTracksRequest) ProtoMessage() {}
+
+func (x *ReorderPlaylistTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPlaylistTracksRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPlaylistTracksRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ReorderPlaylistTracksRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ReorderPlaylistTracksRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ReorderPlaylistTracksRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+func (x *ReorderPlaylistTracksRequest) GetRangeStart() int32 {
+	if x != nil {
+		return x.RangeStart
+	}
+	return 0
+}
+
+func (x *ReorderPlaylistTracksRequest) GetInsertBefore() int32 {
+	if x != nil {
+		return x.InsertBefore
+	}
+	return 0
+}
+
+func (x *ReorderPlaylistTracksRequest) GetRangeLength() int32 {
+	if x != nil {
+		return x.RangeLength
+	}
+	return 0
+}
+
+func (x *ReorderPlaylistTracksRequest) GetSnapshotID() string {
+	if x != nil {
+		return x.SnapshotID
+	}
+	return ""
+}
+
+type ReorderPlaylistTracksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status            string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	BackupSnapshotID  int32  `protobuf:"varint,2,opt,name=backupSnapshotID,proto3" json:"backupSnapshotID,omitempty"`
+	SpotifySnapshotID string `protobuf:"bytes,3,opt,name=spotifySnapshotID,proto3" json:"spotifySnapshotID,omitempty"`
+}
+
+func (x *ReorderPlaylistTracksResponse) Reset() {
+	*x = ReorderPlaylistTracksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderPlaylistTracksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPlaylistTracksResponse) ProtoMessage() {}
+
+func (x *ReorderPlaylistTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPlaylistTracksResponse.ProtoReflect.Descriptor instead.
+func (*ReorderPlaylistTracksResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ReorderPlaylistTracksResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReorderPlaylistTracksResponse) GetBackupSnapshotID() int32 {
+	if x != nil {
+		return x.BackupSnapshotID
+	}
+	return 0
+}
+
+func (x *ReorderPlaylistTracksResponse) GetSpotifySnapshotID() string {
+	if x != nil {
+		return x.SpotifySnapshotID
+	}
+	return ""
+}
+
+type UnfollowPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlaylistID  string `protobuf:"bytes,3,opt,name=playlistID,proto3" json:"playlistID,omitempty"`
+}
+
+func (x *UnfollowPlaylistRequest) Reset() {
+	*x = UnfollowPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowPlaylistRequest) ProtoMessage() {}
+
+func (x *UnfollowPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *UnfollowPlaylistRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *UnfollowPlaylistRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UnfollowPlaylistRequest) GetPlaylistID() string {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return ""
+}
+
+type UnfollowPlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The chart subscription of the playlist is removed with it
+	SubscriptionRemoved bool `protobuf:"varint,2,opt,name=subscriptionRemoved,proto3" json:"subscriptionRemoved,omitempty"`
+}
+
+func (x *UnfollowPlaylistResponse) Reset() {
+	*x = UnfollowPlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowPlaylistResponse) ProtoMessage() {}
+
+func (x *UnfollowPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *UnfollowPlaylistResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UnfollowPlaylistResponse) GetSubscriptionRemoved() bool {
+	if x != nil {
+		return x.SubscriptionRemoved
+	}
+	return false
+}
+
+type GetUserPlaylistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+}
+
+func (x *GetUserPlaylistsRequest) Reset() {
+	*x = GetUserPlaylistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserPlaylistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPlaylistsRequest) ProtoMessage() {}
+
+func (x *GetUserPlaylistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPlaylistsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPlaylistsRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetUserPlaylistsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type Playlist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Next                     string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
+	Total                    int32  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	PlaylistPageURL          string `protobuf:"bytes,3,opt,name=playlistPageURL,proto3" json:"playlistPageURL,omitempty"`
+	DetailedPlaylistEndpoint string `protobuf:"bytes,4,opt,name=detailedPlaylistEndpoint,proto3" json:"detailedPlaylistEndpoint,omitempty"`
+	Name                     string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description              string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	SpotifyPlaylistID        string `protobuf:"bytes,7,opt,name=spotifyPlaylistID,proto3" json:"spotifyPlaylistID,omitempty"`
+	ImageUrl                 string `protobuf:"bytes,8,opt,name=imageUrl,proto3" json:"imageUrl,omitempty"`
+	TotalTracks              int32  `protobuf:"varint,9,opt,name=totalTracks,proto3" json:"totalTracks,omitempty"`
+	TracksEndpoint           string `protobuf:"bytes,10,opt,name=tracksEndpoint,proto3" json:"tracksEndpoint,omitempty"`
+}
+
+func (x *Playlist) Reset() {
+	*x = Playlist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Playlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playlist) ProtoMessage() {}
+
+func (x *Playlist) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playlist.ProtoReflect.Descriptor instead.
+func (*Playlist) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *Playlist) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *Playlist) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Playlist) GetPlaylistPageURL() string {
+	if x != nil {
+		return x.PlaylistPageURL
+	}
+	return ""
+}
+
+func (x *Playlist) GetDetailedPlaylistEndpoint() string {
+	if x != nil {
+		return x.DetailedPlaylistEndpoint
+	}
+	return ""
+}
+
+func (x *Playlist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Playlist) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Playlist) GetSpotifyPlaylistID() string {
+	if x != nil {
+		return x.SpotifyPlaylistID
+	}
+	return ""
+}
+
+func (x *Playlist) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Playlist) GetTotalTracks() int32 {
+	if x != nil {
+		return x.TotalTracks
+	}
+	return 0
+}
+
+func (x *Playlist) GetTracksEndpoint() string {
+	if x != nil {
+		return x.TracksEndpoint
+	}
+	return ""
+}
+
+type GetUserPlaylistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Playlists   []*Playlist `protobuf:"bytes,1,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	NextPageURL string      `protobuf:"bytes,2,opt,name=nextPageURL,proto3" json:"nextPageURL,omitempty"`
+}
+
+func (x *GetUserPlaylistsResponse) Reset() {
+	*x = GetUserPlaylistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserPlaylistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPlaylistsResponse) ProtoMessage() {}
+
+func (x *GetUserPlaylistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPlaylistsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPlaylistsResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetUserPlaylistsResponse) GetPlaylists() []*Playlist {
+	if x != nil {
+		return x.Playlists
+	}
+	return nil
+}
+
+func (x *GetUserPlaylistsResponse) GetNextPageURL() string {
+	if x != nil {
+		return x.NextPageURL
+	}
+	return ""
+}
+
+type PlaylistTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title      string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Artist     string `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"`
+	Popularity int32  `protobuf:"varint,3,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	Uri        string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *PlaylistTrack) Reset() {
+	*x = PlaylistTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistTrack) ProtoMessage() {}
+
+func (x *PlaylistTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistTrack.ProtoReflect.Descriptor instead.
+func (*PlaylistTrack) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *PlaylistTrack) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlaylistTrack) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *PlaylistTrack) GetPopularity() int32 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+func (x *PlaylistTrack) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type GetUserPlaylistTracksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken    string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	TracksEndpoint string `protobuf:"bytes,2,opt,name=tracksEndpoint,proto3" json:"tracksEndpoint,omitempty"`
+}
+
+func (x *GetUserPlaylistTracksRequest) Reset() {
+	*x = GetUserPlaylistTracksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserPlaylistTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPlaylistTracksRequest) ProtoMessage() {}
+
+func (x *GetUserPlaylistTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPlaylistTracksRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPlaylistTracksRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetUserPlaylistTracksRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GetUserPlaylistTracksRequest) GetTracksEndpoint() string {
+	if x != nil {
+		return x.TracksEndpoint
+	}
+	return ""
+}
+
+type GetUserPlaylistTracksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaylistTracks []*PlaylistTrack `protobuf:"bytes,1,rep,name=playlistTracks,proto3" json:"playlistTracks,omitempty"`
+}
+
+func (x *GetUserPlaylistTracksResponse) Reset() {
+	*x = GetUserPlaylistTracksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlist_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserPlaylistTracksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPlaylistTracksResponse) ProtoMessage() {}
+
+func (x *GetUserPlaylistTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPlaylistTracksResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPlaylistTracksResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetUserPlaylistTracksResponse) GetPlaylistTracks() []*PlaylistTrack {
+	if x != nil {
+		return x.PlaylistTracks
+	}
+	return nil
+}
+
+var File_playlist_proto protoreflect.FileDescriptor
+
+var file_playlist_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x7c, 0x0a,
+	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8e, 0x03, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30,
+	0x30, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x72, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x66, 0x72, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x52, 0x61,
+	0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x6f, 0x52, 0x61, 0x6e, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61,
+	0x73, 0x79, 0x6e, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4f, 0x6e, 0x6c,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x72, 0x65, 0x73, 0x68, 0x57, 0x69, 0x74, 0x68, 0x69,
+	0x6e, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0xe7, 0x02, 0x0a,
+	0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31,
+	0x30, 0x30, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x4e, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x89, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31,
+	0x30, 0x30, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x3c, 0x0a, 0x18, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54,
+	0x6f, 0x70, 0x31, 0x30, 0x30, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x33, 0x0a, 0x19, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70,
+	0x31, 0x30, 0x30, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x61, 0x79, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x64, 0x61, 0x79, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x62,
+	0x75, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x84,
+	0x01, 0x0a, 0x15, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x35,
+	0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x18, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5d, 0x0a,
+	0x19, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0c,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xcd, 0x01, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x49, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x49, 0x22, 0x94, 0x01, 0x0a,
+	0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3c, 0x0a,
+	0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x0e, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0xfd, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x55, 0x52, 0x49, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x55, 0x52, 0x49, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0x7b, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44,
+	0x22, 0x44, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xa9, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x72, 0x63, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x57, 0x72, 0x6f,
+	0x6e, 0x67, 0x22, 0x61, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x6c,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x5c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x49, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x49, 0x22, 0xa5, 0x01, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x43, 0x0a,
+	0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x22, 0x4d, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x72, 0x6f, 0x6e,
+	0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x22, 0x78, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x72, 0x6f, 0x6e, 0x67,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x16,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x52, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x55, 0x52, 0x49, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x70, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x55, 0x52, 0x49, 0x22, 0xb5, 0x02, 0x0a, 0x19, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x49, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x49, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x32, 0x0a, 0x08, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xaa,
+	0x02, 0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x7b, 0x0a, 0x1b, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x1c, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfa, 0x01,
+	0x0a, 0x0c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6c, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6c, 0x6f, 0x6e, 0x5f, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6c, 0x6f, 0x6e,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x51, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x59, 0x0a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6c, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6c,
+	0x6f, 0x6e, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6d, 0x65, 0x6c, 0x6f, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x55, 0x52, 0x49, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x70, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x55, 0x52, 0x49, 0x22, 0x5c, 0x0a, 0x1a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x64, 0x22, 0xbe, 0x01, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68,
+	0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44,
+	0x61, 0x79, 0x73, 0x22, 0x65, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x20, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x66, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x6d, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x65, 0x0a, 0x22, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x0e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x74,
+	0x0a, 0x18, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x22, 0x56, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4e, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52,
+	0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x70, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x4d, 0x0a, 0x1c,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x70, 0x0a, 0x1c, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x6c, 0x0a,
+	0x1d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x07, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x24,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x65,
+	0x6e, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65,
+	0x22, 0x61, 0x0a, 0x25, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x41, 0x64,
+	0x64, 0x65, 0x64, 0x22, 0x76, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x64, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x19,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64,
+	0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x55, 0x0a,
+	0x1a, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x61, 0x64, 0x61, 0x72, 0x22, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x64, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x0c,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x22, 0x56, 0x0a, 0x1a,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x1b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x64, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x0c,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x22, 0x52, 0x0a, 0x16,
+	0x52, 0x75, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x31, 0x0a, 0x17, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x0d, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x53, 0x61,
+	0x76, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x19, 0x53, 0x61,
+	0x76, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x6d, 0x61, 0x72, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6d, 0x61, 0x72, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x5a, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x73, 0x6d, 0x61, 0x72,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0e, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x66, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x59,
+	0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x0d, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6d, 0x61,
+	0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x73, 0x6d, 0x61, 0x72,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x1c, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0xb2, 0x01, 0x0a, 0x12, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x61, 0x79, 0x73, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x64, 0x61, 0x79, 0x73, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x43, 0x68, 0x61, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x1d, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6d, 0x61, 0x72,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x73,
+	0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x69,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x69, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x78, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x6a, 0x0a, 0x1e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbb, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x73,
+	0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x49, 0x44, 0x22, 0xfb, 0x01, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x69,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x69, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x73,
+	0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x64, 0x6f, 0x6e,
+	0x65, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x64, 0x6f, 0x6e,
+	0x65, 0x41, 0x74, 0x22, 0x60, 0x0a, 0x14, 0x55, 0x6e, 0x64, 0x6f, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x64, 0x6f, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2c, 0x0a, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x22, 0x89, 0x01,
+	0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x67, 0x0a, 0x13, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0xd7, 0x02, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x44, 0x0a, 0x0e, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c,
+	0x69, 0x6e, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xa1, 0x02, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x64, 0x75, 0x70, 0x65, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x64, 0x75, 0x70, 0x65, 0x42, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x22, 0xa2, 0x02, 0x0a, 0x16, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x72, 0x69, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x72, 0x69, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xa5, 0x01, 0x0a, 0x15, 0x44, 0x65,
+	0x64, 0x75, 0x70, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x65, 0x64, 0x75, 0x70, 0x65, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x65, 0x64, 0x75, 0x70, 0x65, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x22, 0x90, 0x01, 0x0a, 0x0e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x6b, 0x65, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6b, 0x65, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdb, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x64, 0x75, 0x70, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x49, 0x44, 0x22, 0x6e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x22, 0xbf, 0x02, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6c,
+	0x62, 0x75, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69,
+	0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x22, 0xbc, 0x03, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x62, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12,
+	0x32, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x22, 0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xfa, 0x01,
+	0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
+	0x0a, 0x10, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x37, 0x0a, 0x1d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x45, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe1, 0x01, 0x0a, 0x1b, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x69, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x69, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x22, 0x90,
+	0x01, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
+	0x44, 0x22, 0xfe, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x49, 0x44, 0x22, 0x91, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x70, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x22, 0x73, 0x0a, 0x17, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x64, 0x0a, 0x18, 0x55,
+	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x30, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x22, 0x3b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe4,
+	0x02, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x12,
+	0x3a, 0x0a, 0x18, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x18, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x70,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x6b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x55,
+	0x52, 0x4c, 0x22, 0x6f, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x22, 0x68, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x5d, 0x0a,
+	0x1d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x0e, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x32, 0xa1, 0x21, 0x0a,
+	0x0f, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x4d, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f,
+	0x70, 0x31, 0x30, 0x30, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x4a,
+	0x6f, 0x62, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e,
+	0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f,
+	0x70, 0x31, 0x30, 0x30, 0x44, 0x42, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x44, 0x42,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x44,
+	0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4a, 0x6f,
+	0x62, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x72, 0x6f,
+	0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x6f, 0x77, 0x1a,
+	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x5f, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x22,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a,
+	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6e, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x71, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a,
+	0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72,
+	0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x12, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x75, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x53,
+	0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x68, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x25, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x55, 0x6e,
+	0x64, 0x6f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x69, 0x6e, 0x65, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x4d, 0x0a, 0x0e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x44, 0x65, 0x64, 0x75, 0x70, 0x65, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x64, 0x75, 0x70, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x64,
+	0x75, 0x70, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a,
+	0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x15, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_playlist_proto_rawDescOnce sync.Once
+	file_playlist_proto_rawDescData = file_playlist_proto_rawDesc
+)
+
+func file_playlist_proto_rawDescGZIP() []byte {
+	file_playlist_proto_rawDescOnce.Do(func() {
+		file_playlist_proto_rawDescData = protoimpl.X.CompressGZIP(file_playlist_proto_rawDescData)
+	})
+	return file_playlist_proto_rawDescData
+}
+
+var file_playlist_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
+var file_playlist_proto_goTypes = []interface{}{
+	(*CreatePlaylistRequest)(nil),                 // 0: proto.CreatePlaylistRequest
+	(*CreatePlaylistResponse)(nil),                // 1: proto.CreatePlaylistResponse
+	(*CreateMelonTop100Request)(nil),              // 2: proto.CreateMelonTop100Request
+	(*CreateMelonTop100Response)(nil),             // 3: proto.CreateMelonTop100Response
+	(*GetCreateMelonTop100JobRequest)(nil),        // 4: proto.GetCreateMelonTop100JobRequest
+	(*GetCreateMelonTop100JobResponse)(nil),       // 5: proto.GetCreateMelonTop100JobResponse
+	(*SaveMelonTop100DBRequest)(nil),              // 6: proto.SaveMelonTop100DBRequest
+	(*SaveMelonTop100DBResponse)(nil),             // 7: proto.SaveMelonTop100DBResponse
+	(*MissedTrack)(nil),                           // 8: proto.MissedTrack
+	(*GetMissedTracksRequest)(nil),                // 9: proto.GetMissedTracksRequest
+	(*GetMissedTrackResponse)(nil),                // 10: proto.GetMissedTrackResponse
+	(*TrackCandidate)(nil),                        // 11: proto.TrackCandidate
+	(*MissedTrackCandidates)(nil),                 // 12: proto.MissedTrackCandidates
+	(*SuggestCandidatesRequest)(nil),              // 13: proto.SuggestCandidatesRequest
+	(*SuggestCandidatesResponse)(nil),             // 14: proto.SuggestCandidatesResponse
+	(*ResolvedTrack)(nil),                         // 15: proto.ResolvedTrack
+	(*ResolveMissedTracksRequest)(nil),            // 16: proto.ResolveMissedTracksRequest
+	(*ResolveResult)(nil),                         // 17: proto.ResolveResult
+	(*ResolveMissedTracksResponse)(nil),           // 18: proto.ResolveMissedTracksResponse
+	(*GetResolveJobRequest)(nil),                  // 19: proto.GetResolveJobRequest
+	(*GetResolveJobResponse)(nil),                 // 20: proto.GetResolveJobResponse
+	(*ResolvedMapping)(nil),                       // 21: proto.ResolvedMapping
+	(*ListResolvedTracksRequest)(nil),             // 22: proto.ListResolvedTracksRequest
+	(*ListResolvedTracksResponse)(nil),            // 23: proto.ListResolvedTracksResponse
+	(*UpdateResolvedTrackRequest)(nil),            // 24: proto.UpdateResolvedTrackRequest
+	(*UpdateResolvedTrackResponse)(nil),           // 25: proto.UpdateResolvedTrackResponse
+	(*DeleteResolvedTrackRequest)(nil),            // 26: proto.DeleteResolvedTrackRequest
+	(*DeleteResolvedTrackResponse)(nil),           // 27: proto.DeleteResolvedTrackResponse
+	(*ReportWrongMatchRequest)(nil),               // 28: proto.ReportWrongMatchRequest
+	(*ReportWrongMatchResponse)(nil),              // 29: proto.ReportWrongMatchResponse
+	(*ImportResolvedTrackRow)(nil),                // 30: proto.ImportResolvedTrackRow
+	(*ImportResolvedTrackResult)(nil),             // 31: proto.ImportResolvedTrackResult
+	(*ImportResolvedTracksResponse)(nil),          // 32: proto.ImportResolvedTracksResponse
+	(*ExportResolvedTracksRequest)(nil),           // 33: proto.ExportResolvedTracksRequest
+	(*ExportResolvedTracksResponse)(nil),          // 34: proto.ExportResolvedTracksResponse
+	(*PendingMatch)(nil),                          // 35: proto.PendingMatch
+	(*ListPendingMatchesRequest)(nil),             // 36: proto.ListPendingMatchesRequest
+	(*ListPendingMatchesResponse)(nil),            // 37: proto.ListPendingMatchesResponse
+	(*ReviewPendingMatchRequest)(nil),             // 38: proto.ReviewPendingMatchRequest
+	(*ReviewPendingMatchResponse)(nil),            // 39: proto.ReviewPendingMatchResponse
+	(*PlaylistSubscription)(nil),                  // 40: proto.PlaylistSubscription
+	(*CreatePlaylistSubscriptionRequest)(nil),     // 41: proto.CreatePlaylistSubscriptionRequest
+	(*CreatePlaylistSubscriptionResponse)(nil),    // 42: proto.CreatePlaylistSubscriptionResponse
+	(*ListPlaylistSubscriptionsRequest)(nil),      // 43: proto.ListPlaylistSubscriptionsRequest
+	(*ListPlaylistSubscriptionsResponse)(nil),     // 44: proto.ListPlaylistSubscriptionsResponse
+	(*DeletePlaylistSubscriptionRequest)(nil),     // 45: proto.DeletePlaylistSubscriptionRequest
+	(*DeletePlaylistSubscriptionResponse)(nil),    // 46: proto.DeletePlaylistSubscriptionResponse
+	(*FavoriteArtist)(nil),                        // 47: proto.FavoriteArtist
+	(*AddFavoriteArtistRequest)(nil),              // 48: proto.AddFavoriteArtistRequest
+	(*AddFavoriteArtistResponse)(nil),             // 49: proto.AddFavoriteArtistResponse
+	(*ListFavoriteArtistsRequest)(nil),            // 50: proto.ListFavoriteArtistsRequest
+	(*ListFavoriteArtistsResponse)(nil),           // 51: proto.ListFavoriteArtistsResponse
+	(*RemoveFavoriteArtistRequest)(nil),           // 52: proto.RemoveFavoriteArtistRequest
+	(*RemoveFavoriteArtistResponse)(nil),          // 53: proto.RemoveFavoriteArtistResponse
+	(*ImportFavoriteArtistsRequest)(nil),          // 54: proto.ImportFavoriteArtistsRequest
+	(*ImportFavoriteArtistsResponse)(nil),         // 55: proto.ImportFavoriteArtistsResponse
+	(*CreateFavoriteArtistsPlaylistRequest)(nil),  // 56: proto.CreateFavoriteArtistsPlaylistRequest
+	(*CreateFavoriteArtistsPlaylistResponse)(nil), // 57: proto.CreateFavoriteArtistsPlaylistResponse
+	(*ReleaseRadar)(nil),                          // 58: proto.ReleaseRadar
+	(*EnableReleaseRadarRequest)(nil),             // 59: proto.EnableReleaseRadarRequest
+	(*EnableReleaseRadarResponse)(nil),            // 60: proto.EnableReleaseRadarResponse
+	(*GetReleaseRadarRequest)(nil),                // 61: proto.GetReleaseRadarRequest
+	(*GetReleaseRadarResponse)(nil),               // 62: proto.GetReleaseRadarResponse
+	(*DisableReleaseRadarRequest)(nil),            // 63: proto.DisableReleaseRadarRequest
+	(*DisableReleaseRadarResponse)(nil),           // 64: proto.DisableReleaseRadarResponse
+	(*RunReleaseRadarRequest)(nil),                // 65: proto.RunReleaseRadarRequest
+	(*RunReleaseRadarResponse)(nil),               // 66: proto.RunReleaseRadarResponse
+	(*SmartPlaylist)(nil),                         // 67: proto.SmartPlaylist
+	(*SaveSmartPlaylistRequest)(nil),              // 68: proto.SaveSmartPlaylistRequest
+	(*SaveSmartPlaylistResponse)(nil),             // 69: proto.SaveSmartPlaylistResponse
+	(*ListSmartPlaylistsRequest)(nil),             // 70: proto.ListSmartPlaylistsRequest
+	(*ListSmartPlaylistsResponse)(nil),            // 71: proto.ListSmartPlaylistsResponse
+	(*DeleteSmartPlaylistRequest)(nil),            // 72: proto.DeleteSmartPlaylistRequest
+	(*DeleteSmartPlaylistResponse)(nil),           // 73: proto.DeleteSmartPlaylistResponse
+	(*EvaluateSmartPlaylistRequest)(nil),          // 74: proto.EvaluateSmartPlaylistRequest
+	(*SmartPlaylistTrack)(nil),                    // 75: proto.SmartPlaylistTrack
+	(*EvaluateSmartPlaylistResponse)(nil),         // 76: proto.EvaluateSmartPlaylistResponse
+	(*PlaylistSnapshot)(nil),                      // 77: proto.PlaylistSnapshot
+	(*ListPlaylistSnapshotsRequest)(nil),          // 78: proto.ListPlaylistSnapshotsRequest
+	(*ListPlaylistSnapshotsResponse)(nil),         // 79: proto.ListPlaylistSnapshotsResponse
+	(*RestorePlaylistSnapshotRequest)(nil),        // 80: proto.RestorePlaylistSnapshotRequest
+	(*RestorePlaylistSnapshotResponse)(nil),       // 81: proto.RestorePlaylistSnapshotResponse
+	(*PlaylistOperation)(nil),                     // 82: proto.PlaylistOperation
+	(*UndoOperationRequest)(nil),                  // 83: proto.UndoOperationRequest
+	(*UndoOperationResponse)(nil),                 // 84: proto.UndoOperationResponse
+	(*ExportPlaylistRequest)(nil),                 // 85: proto.ExportPlaylistRequest
+	(*ExportPlaylistChunk)(nil),                   // 86: proto.ExportPlaylistChunk
+	(*ImportTrackListLine)(nil),                   // 87: proto.ImportTrackListLine
+	(*ImportTrackListResult)(nil),                 // 88: proto.ImportTrackListResult
+	(*ImportTrackListResponse)(nil),               // 89: proto.ImportTrackListResponse
+	(*MergePlaylistsRequest)(nil),                 // 90: proto.MergePlaylistsRequest
+	(*MergePlaylistsResponse)(nil),                // 91: proto.MergePlaylistsResponse
+	(*DedupePlaylistRequest)(nil),                 // 92: proto.DedupePlaylistRequest
+	(*DuplicateTrack)(nil),                        // 93: proto.DuplicateTrack
+	(*DedupePlaylistResponse)(nil),                // 94: proto.DedupePlaylistResponse
+	(*GetPlaylistRequest)(nil),                    // 95: proto.GetPlaylistRequest
+	(*PlaylistDetailTrack)(nil),                   // 96: proto.PlaylistDetailTrack
+	(*PlaylistDetail)(nil),                        // 97: proto.PlaylistDetail
+	(*GetPlaylistResponse)(nil),                   // 98: proto.GetPlaylistResponse
+	(*UpdatePlaylistDetailsRequest)(nil),          // 99: proto.UpdatePlaylistDetailsRequest
+	(*UpdatePlaylistDetailsResponse)(nil),         // 100: proto.UpdatePlaylistDetailsResponse
+	(*PlaylistTrackPosition)(nil),                 // 101: proto.PlaylistTrackPosition
+	(*RemovePlaylistTracksRequest)(nil),           // 102: proto.RemovePlaylistTracksRequest
+	(*RemovePlaylistTracksResponse)(nil),          // 103: proto.RemovePlaylistTracksResponse
+	(*ReorderPlaylistTracksRequest)(nil),          // 104: proto.ReorderPlaylistTracksRequest
+	(*ReorderPlaylistTracksResponse)(nil),         // 105: proto.ReorderPlaylistTracksResponse
+	(*UnfollowPlaylistRequest)(nil),               // 106: proto.UnfollowPlaylistRequest
+	(*UnfollowPlaylistResponse)(nil),              // 107: proto.UnfollowPlaylistResponse
+	(*GetUserPlaylistsRequest)(nil),               // 108: proto.GetUserPlaylistsRequest
+	(*Playlist)(nil),                              // 109: proto.Playlist
+	(*GetUserPlaylistsResponse)(nil),              // 110: proto.GetUserPlaylistsResponse
+	(*PlaylistTrack)(nil),                         // 111: proto.PlaylistTrack
+	(*GetUserPlaylistTracksRequest)(nil),          // 112: proto.GetUserPlaylistTracksRequest
+	(*GetUserPlaylistTracksResponse)(nil),         // 113: proto.GetUserPlaylistTracksResponse
+}
+var file_playlist_proto_depIdxs = []int32{
+	8,   // 0: proto.CreateMelonTop100Response.missingTracks:type_name -> proto.MissedTrack
+	3,   // 1: proto.GetCreateMelonTop100JobResponse.result:type_name -> proto.CreateMelonTop100Response
+	8,   // 2: proto.GetMissedTrackResponse.missedTracks:type_name -> proto.MissedTrack
+	8,   // 3: proto.MissedTrackCandidates.missedTrack:type_name -> proto.MissedTrack
+	11,  // 4: proto.MissedTrackCandidates.candidates:type_name -> proto.TrackCandidate
+	12,  // 5: proto.SuggestCandidatesResponse.missedTracks:type_name -> proto.MissedTrackCandidates
+	15,  // 6: proto.ResolveMissedTracksRequest.resolvedTracks:type_name -> proto.ResolvedTrack
+	17,  // 7: proto.ResolveMissedTracksResponse.results:type_name -> proto.ResolveResult
+	17,  // 8: proto.GetResolveJobResponse.results:type_name -> proto.ResolveResult
+	21,  // 9: proto.ListResolvedTracksResponse.resolvedTracks:type_name -> proto.ResolvedMapping
+	21,  // 10: proto.UpdateResolvedTrackResponse.resolvedTrack:type_name -> proto.ResolvedMapping
+	21,  // 11: proto.ImportResolvedTrackResult.resolvedTrack:type_name -> proto.ResolvedMapping
+	21,  // 12: proto.ImportResolvedTrackResult.existing:type_name -> proto.ResolvedMapping
+	31,  // 13: proto.ImportResolvedTracksResponse.results:type_name -> proto.ImportResolvedTrackResult
+	35,  // 14: proto.ListPendingMatchesResponse.pendingMatches:type_name -> proto.PendingMatch
+	40,  // 15: proto.CreatePlaylistSubscriptionResponse.subscription:type_name -> proto.PlaylistSubscription
+	40,  // 16: proto.ListPlaylistSubscriptionsResponse.subscriptions:type_name -> proto.PlaylistSubscription
+	40,  // 17: proto.DeletePlaylistSubscriptionResponse.subscription:type_name -> proto.PlaylistSubscription
+	47,  // 18: proto.AddFavoriteArtistResponse.artist:type_name -> proto.FavoriteArtist
+	47,  // 19: proto.ListFavoriteArtistsResponse.artists:type_name -> proto.FavoriteArtist
+	47,  // 20: proto.RemoveFavoriteArtistResponse.artist:type_name -> proto.FavoriteArtist
+	47,  // 21: proto.ImportFavoriteArtistsResponse.artists:type_name -> proto.FavoriteArtist
+	58,  // 22: proto.EnableReleaseRadarResponse.releaseRadar:type_name -> proto.ReleaseRadar
+	58,  // 23: proto.GetReleaseRadarResponse.releaseRadar:type_name -> proto.ReleaseRadar
+	58,  // 24: proto.DisableReleaseRadarResponse.releaseRadar:type_name -> proto.ReleaseRadar
+	67,  // 25: proto.SaveSmartPlaylistResponse.smartPlaylist:type_name -> proto.SmartPlaylist
+	67,  // 26: proto.ListSmartPlaylistsResponse.smartPlaylists:type_name -> proto.SmartPlaylist
+	67,  // 27: proto.DeleteSmartPlaylistResponse.smartPlaylist:type_name -> proto.SmartPlaylist
+	75,  // 28: proto.EvaluateSmartPlaylistResponse.tracks:type_name -> proto.SmartPlaylistTrack
+	77,  // 29: proto.ListPlaylistSnapshotsResponse.snapshots:type_name -> proto.PlaylistSnapshot
+	82,  // 30: proto.UndoOperationResponse.operation:type_name -> proto.PlaylistOperation
+	88,  // 31: proto.ImportTrackListResponse.unmatchedLines:type_name -> proto.ImportTrackListResult
+	93,  // 32: proto.DedupePlaylistResponse.duplicates:type_name -> proto.DuplicateTrack
+	96,  // 33: proto.PlaylistDetail.tracks:type_name -> proto.PlaylistDetailTrack
+	97,  // 34: proto.GetPlaylistResponse.playlist:type_name -> proto.PlaylistDetail
+	101, // 35: proto.RemovePlaylistTracksRequest.tracks:type_name -> proto.PlaylistTrackPosition
+	109, // 36: proto.GetUserPlaylistsResponse.playlists:type_name -> proto.Playlist
+	111, // 37: proto.GetUserPlaylistTracksResponse.playlistTracks:type_name -> proto.PlaylistTrack
+	0,   // 38: proto.PlaylistService.CreatePlaylist:input_type -> proto.CreatePlaylistRequest
+	2,   // 39: proto.PlaylistService.CreateMelonTop100:input_type -> proto.CreateMelonTop100Request
+	4,   // 40: proto.PlaylistService.GetCreateMelonTop100Job:input_type -> proto.GetCreateMelonTop100JobRequest
+	6,   // 41: proto.PlaylistService.SaveMelonTop100DB:input_type -> proto.SaveMelonTop100DBRequest
+	9,   // 42: proto.PlaylistService.GetMissedTracks:input_type -> proto.GetMissedTracksRequest
+	13,  // 43: proto.PlaylistService.SuggestCandidates:input_type -> proto.SuggestCandidatesRequest
+	16,  // 44: proto.PlaylistService.ResolveMissedTracks:input_type -> proto.ResolveMissedTracksRequest
+	19,  // 45: proto.PlaylistService.GetResolveJob:input_type -> proto.GetResolveJobRequest
+	22,  // 46: proto.PlaylistService.ListResolvedTracks:input_type -> proto.ListResolvedTracksRequest
+	24,  // 47: proto.PlaylistService.UpdateResolvedTrack:input_type -> proto.UpdateResolvedTrackRequest
+	26,  // 48: proto.PlaylistService.DeleteResolvedTrack:input_type -> proto.DeleteResolvedTrackRequest
+	28,  // 49: proto.PlaylistService.ReportWrongMatch:input_type -> proto.ReportWrongMatchRequest
+	30,  // 50: proto.PlaylistService.ImportResolvedTracks:input_type -> proto.ImportResolvedTrackRow
+	33,  // 51: proto.PlaylistService.ExportResolvedTracks:input_type -> proto.ExportResolvedTracksRequest
+	36,  // 52: proto.PlaylistService.ListPendingMatches:input_type -> proto.ListPendingMatchesRequest
+	38,  // 53: proto.PlaylistService.ReviewPendingMatch:input_type -> proto.ReviewPendingMatchRequest
+	41,  // 54: proto.PlaylistService.CreatePlaylistSubscription:input_type -> proto.CreatePlaylistSubscriptionRequest
+	43,  // 55: proto.PlaylistService.ListPlaylistSubscriptions:input_type -> proto.ListPlaylistSubscriptionsRequest
+	45,  // 56: proto.PlaylistService.DeletePlaylistSubscription:input_type -> proto.DeletePlaylistSubscriptionRequest
+	48,  // 57: proto.PlaylistService.AddFavoriteArtist:input_type -> proto.AddFavoriteArtistRequest
+	50,  // 58: proto.PlaylistService.ListFavoriteArtists:input_type -> proto.ListFavoriteArtistsRequest
+	52,  // 59: proto.PlaylistService.RemoveFavoriteArtist:input_type -> proto.RemoveFavoriteArtistRequest
+	54,  // 60: proto.PlaylistService.ImportFavoriteArtists:input_type -> proto.ImportFavoriteArtistsRequest
+	56,  // 61: proto.PlaylistService.CreateFavoriteArtistsPlaylist:input_type -> proto.CreateFavoriteArtistsPlaylistRequest
+	59,  // 62: proto.PlaylistService.EnableReleaseRadar:input_type -> proto.EnableReleaseRadarRequest
+	61,  // 63: proto.PlaylistService.GetReleaseRadar:input_type -> proto.GetReleaseRadarRequest
+	63,  // 64: proto.PlaylistService.DisableReleaseRadar:input_type -> proto.DisableReleaseRadarRequest
+	65,  // 65: proto.PlaylistService.RunReleaseRadar:input_type -> proto.RunReleaseRadarRequest
+	68,  // 66: proto.PlaylistService.SaveSmartPlaylist:input_type -> proto.SaveSmartPlaylistRequest
+	70,  // 67: proto.PlaylistService.ListSmartPlaylists:input_type -> proto.ListSmartPlaylistsRequest
+	72,  // 68: proto.PlaylistService.DeleteSmartPlaylist:input_type -> proto.DeleteSmartPlaylistRequest
+	74,  // 69: proto.PlaylistService.EvaluateSmartPlaylist:input_type -> proto.EvaluateSmartPlaylistRequest
+	78,  // 70: proto.PlaylistService.ListPlaylistSnapshots:input_type -> proto.ListPlaylistSnapshotsRequest
+	80,  // 71: proto.PlaylistService.RestorePlaylistSnapshot:input_type -> proto.RestorePlaylistSnapshotRequest
+	83,  // 72: proto.PlaylistService.UndoOperation:input_type -> proto.UndoOperationRequest
+	85,  // 73: proto.PlaylistService.ExportPlaylist:input_type -> proto.ExportPlaylistRequest
+	87,  // 74: proto.PlaylistService.ImportTrackList:input_type -> proto.ImportTrackListLine
+	90,  // 75: proto.PlaylistService.MergePlaylists:input_type -> proto.MergePlaylistsRequest
+	92,  // 76: proto.PlaylistService.DedupePlaylist:input_type -> proto.DedupePlaylistRequest
+	95,  // 77: proto.PlaylistService.GetPlaylist:input_type -> proto.GetPlaylistRequest
+	99,  // 78: proto.PlaylistService.UpdatePlaylistDetails:input_type -> proto.UpdatePlaylistDetailsRequest
+	102, // 79: proto.PlaylistService.RemovePlaylistTracks:input_type -> proto.RemovePlaylistTracksRequest
+	104, // 80: proto.PlaylistService.ReorderPlaylistTracks:input_type -> proto.ReorderPlaylistTracksRequest
+	106, // 81: proto.PlaylistService.UnfollowPlaylist:input_type -> proto.UnfollowPlaylistRequest
+	108, // 82: proto.PlaylistService.GetUserPlaylists:input_type -> proto.GetUserPlaylistsRequest
+	112, // 83: proto.PlaylistService.GetUserPlaylistTracks:input_type -> proto.GetUserPlaylistTracksRequest
+	1,   // 84: proto.PlaylistService.CreatePlaylist:output_type -> proto.CreatePlaylistResponse
+	3,   // 85: proto.PlaylistService.CreateMelonTop100:output_type -> proto.CreateMelonTop100Response
+	5,   // 86: proto.PlaylistService.GetCreateMelonTop100Job:output_type -> proto.GetCreateMelonTop100JobResponse
+	7,   // 87: proto.PlaylistService.SaveMelonTop100DB:output_type -> proto.SaveMelonTop100DBResponse
+	10,  // 88: proto.PlaylistService.GetMissedTracks:output_type -> proto.GetMissedTrackResponse
+	14,  // 89: proto.PlaylistService.SuggestCandidates:output_type -> proto.SuggestCandidatesResponse
+	18,  // 90: proto.PlaylistService.ResolveMissedTracks:output_type -> proto.ResolveMissedTracksResponse
+	20,  // 91: proto.PlaylistService.GetResolveJob:output_type -> proto.GetResolveJobResponse
+	23,  // 92: proto.PlaylistService.ListResolvedTracks:output_type -> proto.ListResolvedTracksResponse
+	25,  // 93: proto.PlaylistService.UpdateResolvedTrack:output_type -> proto.UpdateResolvedTrackResponse
+	27,  // 94: proto.PlaylistService.DeleteResolvedTrack:output_type -> proto.DeleteResolvedTrackResponse
+	29,  // 95: proto.PlaylistService.ReportWrongMatch:output_type -> proto.ReportWrongMatchResponse
+	32,  // 96: proto.PlaylistService.ImportResolvedTracks:output_type -> proto.ImportResolvedTracksResponse
+	34,  // 97: proto.PlaylistService.ExportResolvedTracks:output_type -> proto.ExportResolvedTracksResponse
+	37,  // 98: proto.PlaylistService.ListPendingMatches:output_type -> proto.ListPendingMatchesResponse
+	39,  // 99: proto.PlaylistService.ReviewPendingMatch:output_type -> proto.ReviewPendingMatchResponse
+	42,  // 100: proto.PlaylistService.CreatePlaylistSubscription:output_type -> proto.CreatePlaylistSubscriptionResponse
+	44,  // 101: proto.PlaylistService.ListPlaylistSubscriptions:output_type -> proto.ListPlaylistSubscriptionsResponse
+	46,  // 102: proto.PlaylistService.DeletePlaylistSubscription:output_type -> proto.DeletePlaylistSubscriptionResponse
+	49,  // 103: proto.PlaylistService.AddFavoriteArtist:output_type -> proto.AddFavoriteArtistResponse
+	51,  // 104: proto.PlaylistService.ListFavoriteArtists:output_type -> proto.ListFavoriteArtistsResponse
+	53,  // 105: proto.PlaylistService.RemoveFavoriteArtist:output_type -> proto.RemoveFavoriteArtistResponse
+	55,  // 106: proto.PlaylistService.ImportFavoriteArtists:output_type -> proto.ImportFavoriteArtistsResponse
+	57,  // 107: proto.PlaylistService.CreateFavoriteArtistsPlaylist:output_type -> proto.CreateFavoriteArtistsPlaylistResponse
+	60,  // 108: proto.PlaylistService.EnableReleaseRadar:output_type -> proto.EnableReleaseRadarResponse
+	62,  // 109: proto.PlaylistService.GetReleaseRadar:output_type -> proto.GetReleaseRadarResponse
+	64,  // 110: proto.PlaylistService.DisableReleaseRadar:output_type -> proto.DisableReleaseRadarResponse
+	66,  // 111: proto.PlaylistService.RunReleaseRadar:output_type -> proto.RunReleaseRadarResponse
+	69,  // 112: proto.PlaylistService.SaveSmartPlaylist:output_type -> proto.SaveSmartPlaylistResponse
+	71,  // 113: proto.PlaylistService.ListSmartPlaylists:output_type -> proto.ListSmartPlaylistsResponse
+	73,  // 114: proto.PlaylistService.DeleteSmartPlaylist:output_type -> proto.DeleteSmartPlaylistResponse
+	76,  // 115: proto.PlaylistService.EvaluateSmartPlaylist:output_type -> proto.EvaluateSmartPlaylistResponse
+	79,  // 116: proto.PlaylistService.ListPlaylistSnapshots:output_type -> proto.ListPlaylistSnapshotsResponse
+	81,  // 117: proto.PlaylistService.RestorePlaylistSnapshot:output_type -> proto.RestorePlaylistSnapshotResponse
+	84,  // 118: proto.PlaylistService.UndoOperation:output_type -> proto.UndoOperationResponse
+	86,  // 119: proto.PlaylistService.ExportPlaylist:output_type -> proto.ExportPlaylistChunk
+	89,  // 120: proto.PlaylistService.ImportTrackList:output_type -> proto.ImportTrackListResponse
+	91,  // 121: proto.PlaylistService.MergePlaylists:output_type -> proto.MergePlaylistsResponse
+	94,  // 122: proto.PlaylistService.DedupePlaylist:output_type -> proto.DedupePlaylistResponse
+	98,  // 123: proto.PlaylistService.GetPlaylist:output_type -> proto.GetPlaylistResponse
+	100, // 124: proto.PlaylistService.UpdatePlaylistDetails:output_type -> proto.UpdatePlaylistDetailsResponse
+	103, // 125: proto.PlaylistService.RemovePlaylistTracks:output_type -> proto.RemovePlaylistTracksResponse
+	105, // 126: proto.PlaylistService.ReorderPlaylistTracks:output_type -> proto.ReorderPlaylistTracksResponse
+	107, // 127: proto.PlaylistService.UnfollowPlaylist:output_type -> proto.UnfollowPlaylistResponse
+	110, // 128: proto.PlaylistService.GetUserPlaylists:output_type -> proto.GetUserPlaylistsResponse
+	113, // 129: proto.PlaylistService.GetUserPlaylistTracks:output_type -> proto.GetUserPlaylistTracksResponse
+	84,  // [84:130] is the sub-list for method output_type
+	38,  // [38:84] is the sub-list for method input_type
+	38,  // [38:38] is the sub-list for extension type_name
+	38,  // [38:38] is the sub-list for extension extendee
+	0,   // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_playlist_proto_init() }
+func file_playlist_proto_init() {
+	if File_playlist_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_playlist_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMelonTop100Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMelonTop100Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCreateMelonTop100JobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCreateMelonTop100JobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveMelonTop100DBRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveMelonTop100DBResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMissedTracksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMissedTrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedTrackCandidates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestCandidatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestCandidatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolvedTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveMissedTracksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveMissedTracksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResolveJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResolveJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolvedMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResolvedTracksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResolvedTracksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResolvedTrackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResolvedTrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResolvedTrackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResolvedTrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportWrongMatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportWrongMatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportResolvedTrackRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportResolvedTrackResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportResolvedTracksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResolvedTracksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResolvedTracksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingMatchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingMatchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewPendingMatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewPendingMatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlaylistSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlaylistSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlaylistSubscriptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlaylistSubscriptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePlaylistSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePlaylistSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavoriteArtist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFavoriteArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFavoriteArtistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFavoriteArtistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFavoriteArtistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFavoriteArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFavoriteArtistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportFavoriteArtistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportFavoriteArtistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFavoriteArtistsPlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFavoriteArtistsPlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseRadar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableReleaseRadarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableReleaseRadarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReleaseRadarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReleaseRadarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableReleaseRadarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableReleaseRadarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunReleaseRadarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunReleaseRadarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmartPlaylist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveSmartPlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveSmartPlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSmartPlaylistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSmartPlaylistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSmartPlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSmartPlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateSmartPlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmartPlaylistTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateSmartPlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlaylistSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlaylistSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePlaylistSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePlaylistSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoOperationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPlaylistChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTrackListLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTrackListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTrackListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergePlaylistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetUserPlaylistTracksResponse.ProtoReflect.Descriptor instead.
-func (*GetUserPlaylistTracksResponse) Descriptor() ([]byte, []int) {
-	return file_playlist_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *GetUserPlaylistTracksResponse) GetPlaylistTracks() []*PlaylistTrack {
-	if x != nil {
-		return x.PlaylistTracks
-	}
-	return nil
-}
-
-var File_playlist_proto protoreflect.FileDescriptor
-
-var file_playlist_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x6c,
-	0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x7c, 0x0a,
-	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x6c,
-	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x18,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30,
-	0x30, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x33, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3c, 0x0a, 0x18, 0x53,
-	0x61, 0x76, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x44, 0x42,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x33, 0x0a, 0x19, 0x53, 0x61, 0x76,
-	0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x44, 0x42, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x63,
-	0x0a, 0x0b, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a,
-	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e,
-	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x73, 0x73,
-	0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
-	0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x41, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x7c, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3c, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x73, 0x22, 0x35, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69,
-	0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3b, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe4, 0x02, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79,
-	0x6c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28,
-	0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x55, 0x52,
-	0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
-	0x74, 0x50, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x3a, 0x0a, 0x18, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x70,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x6c,
-	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x6b,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x70, 0x6c,
-	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09,
-	0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
-	0x74, 0x50, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x22, 0x6f, 0x0a, 0x0d, 0x50,
-	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f,
-	0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
-	0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x68, 0x0a, 0x1c,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26,
-	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x45, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x6c,
-	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x73, 0x32, 0xf8, 0x04, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x12, 0x1f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c, 0x6f,
-	0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6c,
-	0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x56, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70,
-	0x31, 0x30, 0x30, 0x44, 0x42, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61,
-	0x76, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x44, 0x42, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x61, 0x76, 0x65, 0x4d, 0x65, 0x6c, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x44, 0x42,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d,
-	0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c,
-	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c,
-	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
-	0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_playlist_proto_rawDescOnce sync.Once
-	file_playlist_proto_rawDescData = file_playlist_proto_rawDesc
-)
-
-func file_playlist_proto_rawDescGZIP() []byte {
-	file_playlist_proto_rawDescOnce.Do(func() {
-		file_playlist_proto_rawDescData = protoimpl.X.CompressGZIP(file_playlist_proto_rawDescData)
-	})
-	return file_playlist_proto_rawDescData
-}
-
-var file_playlist_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_playlist_proto_goTypes = []interface{}{
-	(*CreatePlaylistRequest)(nil),         // 0: proto.CreatePlaylistRequest
-	(*CreatePlaylistResponse)(nil),        // 1: proto.CreatePlaylistResponse
-	(*CreateMelonTop100Request)(nil),      // 2: proto.CreateMelonTop100Request
-	(*CreateMelonTop100Response)(nil),     // 3: proto.CreateMelonTop100Response
-	(*SaveMelonTop100DBRequest)(nil),      // 4: proto.SaveMelonTop100DBRequest
-	(*SaveMelonTop100DBResponse)(nil),     // 5: proto.SaveMelonTop100DBResponse
-	(*MissedTrack)(nil),                   // 6: proto.MissedTrack
-	(*GetMissedTracksRequest)(nil),        // 7: proto.GetMissedTracksRequest
-	(*GetMissedTrackResponse)(nil),        // 8: proto.GetMissedTrackResponse
-	(*ResolvedTrack)(nil),                 // 9: proto.ResolvedTrack
-	(*ResolveMissedTracksRequest)(nil),    // 10: proto.ResolveMissedTracksRequest
-	(*ResolveMissedTracksResponse)(nil),   // 11: proto.ResolveMissedTracksResponse
-	(*GetUserPlaylistsRequest)(nil),       // 12: proto.GetUserPlaylistsRequest
-	(*Playlist)(nil),                      // 13: proto.Playlist
-	(*GetUserPlaylistsResponse)(nil),      // 14: proto.GetUserPlaylistsResponse
-	(*PlaylistTrack)(nil),                 // 15: proto.PlaylistTrack
-	(*GetUserPlaylistTracksRequest)(nil),  // 16: proto.GetUserPlaylistTracksRequest
-	(*GetUserPlaylistTracksResponse)(nil), // 17: proto.GetUserPlaylistTracksResponse
-}
-var file_playlist_proto_depIdxs = []int32{
-	6,  // 0: proto.GetMissedTrackResponse.missedTracks:type_name -> proto.MissedTrack
-	9,  // 1: proto.ResolveMissedTracksRequest.resolvedTracks:type_name -> proto.ResolvedTrack
-	13, // 2: proto.GetUserPlaylistsResponse.playlists:type_name -> proto.Playlist
-	15, // 3: proto.GetUserPlaylistTracksResponse.playlistTracks:type_name -> proto.PlaylistTrack
-	0,  // 4: proto.PlaylistService.CreatePlaylist:input_type -> proto.CreatePlaylistRequest
-	2,  // 5: proto.PlaylistService.CreateMelonTop100:input_type -> proto.CreateMelonTop100Request
-	4,  // 6: proto.PlaylistService.SaveMelonTop100DB:input_type -> proto.SaveMelonTop100DBRequest
-	7,  // 7: proto.PlaylistService.GetMissedTracks:input_type -> proto.GetMissedTracksRequest
-	10, // 8: proto.PlaylistService.ResolveMissedTracks:input_type -> proto.ResolveMissedTracksRequest
-	12, // 9: proto.PlaylistService.GetUserPlaylists:input_type -> proto.GetUserPlaylistsRequest
-	16, // 10: proto.PlaylistService.GetUserPlaylistTracks:input_type -> proto.GetUserPlaylistTracksRequest
-	1,  // 11: proto.PlaylistService.CreatePlaylist:output_type -> proto.CreatePlaylistResponse
-	3,  // 12: proto.PlaylistService.CreateMelonTop100:output_type -> proto.CreateMelonTop100Response
-	5,  // 13: proto.PlaylistService.SaveMelonTop100DB:output_type -> proto.SaveMelonTop100DBResponse
-	8,  // 14: proto.PlaylistService.GetMissedTracks:output_type -> proto.GetMissedTrackResponse
-	11, // 15: proto.PlaylistService.ResolveMissedTracks:output_type -> proto.ResolveMissedTracksResponse
-	14, // 16: proto.PlaylistService.GetUserPlaylists:output_type -> proto.GetUserPlaylistsResponse
-	17, // 17: proto.PlaylistService.GetUserPlaylistTracks:output_type -> proto.GetUserPlaylistTracksResponse
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
-}
-
-func init() { file_playlist_proto_init() }
-func file_playlist_proto_init() {
-	if File_playlist_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_playlist_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePlaylistRequest); i {
+		file_playlist_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergePlaylistsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1385,8 +10826,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePlaylistResponse); i {
+		file_playlist_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DedupePlaylistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1397,8 +10838,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMelonTop100Request); i {
+		file_playlist_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateTrack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1409,8 +10850,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMelonTop100Response); i {
+		file_playlist_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DedupePlaylistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1421,8 +10862,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveMelonTop100DBRequest); i {
+		file_playlist_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaylistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1433,8 +10874,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveMelonTop100DBResponse); i {
+		file_playlist_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistDetailTrack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1445,8 +10886,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MissedTrack); i {
+		file_playlist_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistDetail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1457,8 +10898,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMissedTracksRequest); i {
+		file_playlist_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaylistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1469,8 +10910,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMissedTrackResponse); i {
+		file_playlist_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePlaylistDetailsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1481,8 +10922,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolvedTrack); i {
+		file_playlist_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePlaylistDetailsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1493,8 +10934,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolveMissedTracksRequest); i {
+		file_playlist_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistTrackPosition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1505,8 +10946,8 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolveMissedTracksResponse); i {
+		file_playlist_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePlaylistTracksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1517,7 +10958,67 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_playlist_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePlaylistTracksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderPlaylistTracksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderPlaylistTracksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowPlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowPlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlist_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserPlaylistsRequest); i {
 			case 0:
 				return &v.state
@@ -1529,7 +11030,7 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_playlist_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Playlist); i {
 			case 0:
 				return &v.state
@@ -1541,7 +11042,7 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_playlist_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserPlaylistsResponse); i {
 			case 0:
 				return &v.state
@@ -1553,7 +11054,7 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_playlist_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlaylistTrack); i {
 			case 0:
 				return &v.state
@@ -1565,7 +11066,7 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_playlist_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserPlaylistTracksRequest); i {
 			case 0:
 				return &v.state
@@ -1577,7 +11078,7 @@ func file_playlist_proto_init() {
 				return nil
 			}
 		}
-		file_playlist_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_playlist_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserPlaylistTracksResponse); i {
 			case 0:
 				return &v.state
@@ -1596,7 +11097,7 @@ func file_playlist_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_playlist_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   114,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
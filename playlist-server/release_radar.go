@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+	"github.com/akimdev15/mscraper"
+)
+
+const (
+	// How often each release radar is updated
+	releaseRadarInterval = 7 * 24 * time.Hour
+	// How often the scheduler looks for the release radars to update
+	releaseRadarCheckInterval = time.Hour
+
+	releaseRadarPlaylistName        = "Korean Release Radar"
+	releaseRadarPlaylistDescription = "New releases by your favorite artists, updated weekly by melongo"
+)
+
+// EnableReleaseRadar turns on the weekly release radar of the user, writing to the playlist (or a new one)
+func (playlistServer *PlaylistServer) EnableReleaseRadar(ctx context.Context, req *proto.EnableReleaseRadarRequest) (*proto.EnableReleaseRadarResponse, error) {
+	if req.UserID == "" {
+		return nil, fmt.Errorf("userID is required")
+	}
+
+	playlistID := req.PlaylistID
+	if playlistID == "" {
+		newPlaylist, err := spotify.CreateNewPlaylist(releaseRadarPlaylistName, releaseRadarPlaylistDescription, false, req.UserID, req.AccessToken)
+		if err != nil {
+			slog.Error("Error creating the release radar playlist", "userID", req.UserID, "error", err)
+			return nil, err
+		}
+		playlistID = newPlaylist.SpotifyPlaylistID
+	}
+
+	releaseRadar, err := playlistServer.DB.UpsertReleaseRadar(ctx, database.UpsertReleaseRadarParams{
+		UserID:          req.UserID,
+		PlaylistID:      playlistID,
+		IncludeFollowed: req.IncludeFollowed,
+	})
+	if err != nil {
+		slog.Error("Error saving the release radar", "userID", req.UserID, "error", err)
+		return nil, fmt.Errorf("error saving the release radar: %v", err)
+	}
+
+	slog.Info("Enabled release radar", "userID", req.UserID, "playlistID", playlistID, "includeFollowed", req.IncludeFollowed)
+
+	return &proto.EnableReleaseRadarResponse{
+		ReleaseRadar: convertReleaseRadarToProto(releaseRadar),
+	}, nil
+}
+
+func (playlistServer *PlaylistServer) GetReleaseRadar(ctx context.Context, req *proto.GetReleaseRadarRequest) (*proto.GetReleaseRadarResponse, error) {
+	releaseRadar, err := playlistServer.DB.GetReleaseRadar(ctx, req.UserID)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("release radar is not enabled")
+	} else if err != nil {
+		slog.Error("Error getting the release radar", "userID", req.UserID, "error", err)
+		return nil, err
+	}
+
+	return &proto.GetReleaseRadarResponse{
+		ReleaseRadar: convertReleaseRadarToProto(releaseRadar),
+	}, nil
+}
+
+// DisableReleaseRadar stops updating the release radar. The playlist itself is kept on spotify
+func (playlistServer *PlaylistServer) DisableReleaseRadar(ctx context.Context, req *proto.DisableReleaseRadarRequest) (*proto.DisableReleaseRadarResponse, error) {
+	releaseRadar, err := playlistServer.DB.DeleteReleaseRadar(ctx, req.UserID)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("release radar is not enabled")
+	} else if err != nil {
+		slog.Error("Error deleting the release radar", "userID", req.UserID, "error", err)
+		return nil, err
+	}
+
+	return &proto.DisableReleaseRadarResponse{
+		ReleaseRadar: convertReleaseRadarToProto(releaseRadar),
+	}, nil
+}
+
+// RunReleaseRadar updates the user's release radar in the background with the token of the request
+func (playlistServer *PlaylistServer) RunReleaseRadar(ctx context.Context, req *proto.RunReleaseRadarRequest) (*proto.RunReleaseRadarResponse, error) {
+	releaseRadar, err := playlistServer.DB.GetReleaseRadar(ctx, req.UserID)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("release radar is not enabled")
+	} else if err != nil {
+		slog.Error("Error getting the release radar", "userID", req.UserID, "error", err)
+		return nil, err
+	}
+
+	go func() {
+		if _, err := playlistServer.runReleaseRadar(context.Background(), releaseRadar, req.AccessToken); err != nil {
+			slog.Error("Error running the release radar", "userID", req.UserID, "error", err)
+		}
+	}()
+
+	return &proto.RunReleaseRadarResponse{
+		Status: fmt.Sprintf("Updating the release radar playlist: %s", releaseRadar.PlaylistID),
+	}, nil
+}
+
+// runReleaseRadarScheduler updates every release radar which wasn't updated in the last week.
+// Runs for the lifetime of the server with the token of each user from the auth server
+func (playlistServer *PlaylistServer) runReleaseRadarScheduler() {
+	ticker := time.NewTicker(releaseRadarCheckInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ctx := context.Background()
+
+		releaseRadars, err := playlistServer.DB.ListDueReleaseRadars(ctx, sql.NullTime{Time: time.Now().Add(-releaseRadarInterval), Valid: true})
+		if err != nil {
+			slog.Error("Error listing the release radars to update", "error", err)
+			continue
+		}
+
+		for _, releaseRadar := range releaseRadars {
+			accessToken, err := getUserAccessToken(releaseRadar.UserID)
+			if err != nil {
+				continue
+			}
+
+			if _, err := playlistServer.runReleaseRadar(ctx, releaseRadar, accessToken); err != nil {
+				slog.Error("Error running the release radar", "userID", releaseRadar.UserID, "error", err)
+			}
+		}
+	}
+}
+
+// runReleaseRadar replaces the release radar playlist with the newest melon releases by the user's favorite artists
+// which weren't delivered before. Returns the number of tracks written to the playlist
+func (playlistServer *PlaylistServer) runReleaseRadar(ctx context.Context, releaseRadar database.ReleaseRadar, accessToken string) (int, error) {
+	favoriteArtists, err := playlistServer.DB.ListFavoriteArtists(ctx, releaseRadar.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("error listing favorite artists: %v", err)
+	}
+
+	if releaseRadar.IncludeFollowed {
+		followedArtists, err := spotify.GetFollowedArtists(accessToken)
+		if err != nil {
+			return 0, fmt.Errorf("error getting the followed artists: %v", err)
+		}
+		for _, artist := range followedArtists {
+			favoriteArtists = append(favoriteArtists, database.FavoriteArtist{
+				SpotifyArtistID: artist.ID,
+				Name:            artist.Name,
+				Source:          favoriteSourceFollowed,
+			})
+		}
+	}
+
+	var uris []string
+	if len(favoriteArtists) > 0 {
+		// Every track ever delivered by melongo, not only by the release radar
+		delivered, err := playlistServer.getDeliveredTracks(ctx, releaseRadar.UserID, 0)
+		if err != nil {
+			return 0, fmt.Errorf("error getting the delivered tracks: %v", err)
+		}
+
+		uris = newReleaseURIs(mscraper.GetNewestAlbumFromMelon(), newFavoriteArtistSet(favoriteArtists), delivered, accessToken)
+	}
+
+	// Keep last week's tracks when there is nothing new
+	if len(uris) > 0 {
+		if _, err := playlistServer.replaceUserPlaylistTracks(ctx, releaseRadar.UserID, releaseRadar.PlaylistID, operationReleaseRadar, uris, accessToken); err != nil {
+			return 0, err
+		}
+	}
+
+	err = playlistServer.DB.UpdateReleaseRadarRun(ctx, database.UpdateReleaseRadarRunParams{
+		UserID:    releaseRadar.UserID,
+		LastRunAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return len(uris), err
+	}
+
+	slog.Info("Updated release radar", "userID", releaseRadar.UserID, "playlistID", releaseRadar.PlaylistID, "artists", len(favoriteArtists), "tracks", len(uris))
+	return len(uris), nil
+}
+
+func convertReleaseRadarToProto(releaseRadar database.ReleaseRadar) *proto.ReleaseRadar {
+	protoReleaseRadar := &proto.ReleaseRadar{
+		PlaylistID:      releaseRadar.PlaylistID,
+		IncludeFollowed: releaseRadar.IncludeFollowed,
+	}
+	if releaseRadar.LastRunAt.Valid {
+		protoReleaseRadar.LastRunAt = releaseRadar.LastRunAt.Time.Format(time.RFC3339)
+	}
+	return protoReleaseRadar
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+)
+
+// Status of each row of ImportResolvedTracks
+const (
+	importStatusCreated   = "created"   // new mapping
+	importStatusUpdated   = "updated"   // existing mapping replaced (overwrite)
+	importStatusUnchanged = "unchanged" // same mapping already exists
+	importStatusConflict  = "conflict"  // different mapping already exists (not overwritten)
+	importStatusInvalid   = "invalid"   // missing fields, duplicate row or the URI isn't found on spotify
+	importStatusFailed    = "failed"    // error while saving the mapping
+)
+
+// Formats supported by ExportResolvedTracks
+const (
+	exportFormatCSV  = "csv"
+	exportFormatJSON = "json"
+)
+
+// Column names of the CSV export. Import (from the broker) uses the same names for missed_title, missed_artist and spotify_uri
+var resolvedTrackCSVHeader = []string{"missed_title", "missed_artist", "spotify_uri", "title", "artist", "album", "isrc", "duration_ms", "resolved_date", "reported_wrong"}
+
+// resolvedTrackExport is a single mapping of the JSON export
+type resolvedTrackExport struct {
+	MissedTitle   string `json:"missedTitle"`
+	MissedArtist  string `json:"missedArtist"`
+	SpotifyURI    string `json:"spotifyURI"`
+	Title         string `json:"title"`
+	Artist        string `json:"artist"`
+	Album         string `json:"album"`
+	ISRC          string `json:"isrc"`
+	DurationMs    int32  `json:"durationMs"`
+	ResolvedDate  string `json:"resolvedDate"`
+	ReportedWrong bool   `json:"reportedWrong"`
+}
+
+// ImportResolvedTracks imports the melon to spotify mappings streamed by the client.
+// Every URI is validated against spotify and compared with the existing mapping.
+// With dryRun, nothing is saved and only the results are returned
+func (playlistServer *PlaylistServer) ImportResolvedTracks(stream proto.PlaylistService_ImportResolvedTracksServer) error {
+	response := &proto.ImportResolvedTracksResponse{}
+
+	var options *proto.ImportResolvedTrackRow
+	// row number of each missed title and artist to find the duplicates in the import
+	importedRows := make(map[string]int32)
+
+	for row := int32(1); ; row++ {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			slog.Error("Error receiving the imported row", "row", row, "error", err)
+			return err
+		}
+
+		if options == nil {
+			options = req
+			response.DryRun = req.DryRun
+		}
+
+		result := playlistServer.importResolvedTrack(stream.Context(), row, req, options, importedRows)
+		response.Results = append(response.Results, result)
+
+		switch result.Status {
+		case importStatusCreated:
+			response.Created++
+		case importStatusUpdated:
+			response.Updated++
+		case importStatusUnchanged:
+			response.Unchanged++
+		case importStatusConflict:
+			response.Conflicts++
+		case importStatusInvalid:
+			response.Invalid++
+		case importStatusFailed:
+			response.Failed++
+		}
+	}
+
+	response.Total = int32(len(response.Results))
+	slog.Info("Imported resolved tracks", "dryRun", response.DryRun, "total", response.Total, "created", response.Created, "updated", response.Updated, "conflicts", response.Conflicts, "invalid", response.Invalid, "failed", response.Failed)
+
+	return stream.SendAndClose(response)
+}
+
+// importResolvedTrack validates and saves (unless dry run) a single imported row
+func (playlistServer *PlaylistServer) importResolvedTrack(ctx context.Context, row int32, req *proto.ImportResolvedTrackRow, options *proto.ImportResolvedTrackRow, importedRows map[string]int32) *proto.ImportResolvedTrackResult {
+	result := &proto.ImportResolvedTrackResult{
+		Row:          row,
+		MissedTitle:  strings.TrimSpace(req.MissedTitle),
+		MissedArtist: strings.TrimSpace(req.MissedArtist),
+		SpotifyURI:   strings.TrimSpace(req.SpotifyURI),
+	}
+
+	if result.MissedTitle == "" || result.MissedArtist == "" || result.SpotifyURI == "" {
+		result.Status = importStatusInvalid
+		result.Error = "missed_title, missed_artist and spotifyURI are required"
+		return result
+	}
+
+	key := result.MissedTitle + "\x00" + result.MissedArtist
+	if previousRow, ok := importedRows[key]; ok {
+		result.Status = importStatusInvalid
+		result.Error = fmt.Sprintf("duplicate of row %d", previousRow)
+		return result
+	}
+	importedRows[key] = row
+
+	searchedTrack, err := spotify.GetTrack(result.SpotifyURI, options.AccessToken)
+	if err != nil || searchedTrack == nil || searchedTrack.URI == "" {
+		result.Status = importStatusInvalid
+		result.Error = fmt.Sprintf("track not found on spotify: %v", err)
+		return result
+	}
+
+	result.ResolvedTrack = &proto.ResolvedMapping{
+		MissedTitle:  result.MissedTitle,
+		MissedArtist: result.MissedArtist,
+		Title:        searchedTrack.Name,
+		Artist:       searchedTrack.Artist,
+		Uri:          searchedTrack.URI,
+		Album:        searchedTrack.Album,
+		DurationMs:   int32(searchedTrack.DurationMs),
+		Isrc:         searchedTrack.ISRC,
+	}
+
+	existing, err := playlistServer.DB.GetResolvedTrack(ctx, database.GetResolvedTrackParams{
+		MissedTitle:  result.MissedTitle,
+		MissedArtist: result.MissedArtist,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		slog.Error("Error getting resolved track", "row", row, "error", err)
+		result.Status = importStatusFailed
+		result.Error = fmt.Sprintf("error getting the existing mapping: %v", err)
+		return result
+	}
+
+	// New mapping. Saved same as resolving the missed track with the spotify URI
+	if err == sql.ErrNoRows {
+		result.Status = importStatusCreated
+		if options.DryRun {
+			return result
+		}
+
+		_, backfilled, err := playlistServer.performDBTXForResolvedTrack(&proto.ResolvedTrack{
+			MissedTitle:  result.MissedTitle,
+			MissedArtist: result.MissedArtist,
+			Title:        searchedTrack.Name,
+			Artist:       searchedTrack.Artist,
+			SpotifyURI:   searchedTrack.URI,
+		}, searchedTrack, matchMethodManualURI)
+		if err != nil {
+			result.Status = importStatusFailed
+			result.Error = fmt.Sprintf("error saving the mapping: %v", err)
+			return result
+		}
+		// Imported by an admin so every user's playlist built for the dates gets the track
+		playlistServer.addResolvedTrackToPlaylists(searchedTrack.URI, backfilled, "", "")
+		return result
+	}
+
+	if existing.Uri == searchedTrack.URI {
+		result.Status = importStatusUnchanged
+		return result
+	}
+
+	result.Existing = convertResolvedTrackToProto(existing)
+	if !options.Overwrite {
+		result.Status = importStatusConflict
+		result.Error = fmt.Sprintf("already mapped to %s", existing.Uri)
+		return result
+	}
+
+	result.Status = importStatusUpdated
+	if options.DryRun {
+		return result
+	}
+
+	if _, _, _, err := playlistServer.updateResolvedTrackTX(ctx, result.MissedTitle, result.MissedArtist, searchedTrack); err != nil {
+		result.Status = importStatusFailed
+		result.Error = fmt.Sprintf("error updating the mapping: %v", err)
+	}
+	return result
+}
+
+// ExportResolvedTracks returns every saved mapping as a CSV or JSON file
+func (playlistServer *PlaylistServer) ExportResolvedTracks(ctx context.Context, req *proto.ExportResolvedTracksRequest) (*proto.ExportResolvedTracksResponse, error) {
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = exportFormatCSV
+	}
+	if format != exportFormatCSV && format != exportFormatJSON {
+		return nil, fmt.Errorf("unsupported export format: %s", req.Format)
+	}
+
+	resolvedTracks, err := playlistServer.DB.ListResolvedTracks(ctx, req.ReportedOnly)
+	if err != nil {
+		slog.Error("Error listing resolved tracks", "error", err)
+		return nil, fmt.Errorf("error listing resolved tracks: %v", err)
+	}
+
+	response := &proto.ExportResolvedTracksResponse{
+		Format: format,
+		Count:  int32(len(resolvedTracks)),
+	}
+
+	switch format {
+	case exportFormatCSV:
+		response.ContentType = "text/csv"
+		response.Data, err = resolvedTracksToCSV(resolvedTracks)
+	case exportFormatJSON:
+		response.ContentType = "application/json"
+		response.Data, err = resolvedTracksToJSON(resolvedTracks)
+	}
+	if err != nil {
+		slog.Error("Error exporting resolved tracks", "format", format, "error", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func resolvedTracksToCSV(resolvedTracks []database.ResolvedTrack) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(resolvedTrackCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, resolvedTrack := range resolvedTracks {
+		err := writer.Write([]string{
+			resolvedTrack.MissedTitle,
+			resolvedTrack.MissedArtist,
+			resolvedTrack.Uri,
+			resolvedTrack.Title,
+			resolvedTrack.Artist,
+			resolvedTrack.Album,
+			resolvedTrack.Isrc,
+			strconv.Itoa(int(resolvedTrack.DurationMs)),
+			resolvedTrack.Date.Format("2006-01-02"),
+			strconv.FormatBool(resolvedTrack.ReportedWrong),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+func resolvedTracksToJSON(resolvedTracks []database.ResolvedTrack) ([]byte, error) {
+	exports := make([]resolvedTrackExport, 0, len(resolvedTracks))
+	for _, resolvedTrack := range resolvedTracks {
+		exports = append(exports, resolvedTrackExport{
+			MissedTitle:   resolvedTrack.MissedTitle,
+			MissedArtist:  resolvedTrack.MissedArtist,
+			SpotifyURI:    resolvedTrack.Uri,
+			Title:         resolvedTrack.Title,
+			Artist:        resolvedTrack.Artist,
+			Album:         resolvedTrack.Album,
+			ISRC:          resolvedTrack.Isrc,
+			DurationMs:    resolvedTrack.DurationMs,
+			ResolvedDate:  resolvedTrack.Date.Format("2006-01-02"),
+			ReportedWrong: resolvedTrack.ReportedWrong,
+		})
+	}
+
+	return json.MarshalIndent(exports, "", "  ")
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+)
+
+// Actions of ReviewPendingMatch
+const (
+	reviewActionApprove = "approve" // the matched track is correct
+	reviewActionReject  = "reject"  // wrong track. Moved to the missed tracks to be resolved
+	reviewActionReplace = "replace" // wrong track. Replaced with the spotifyURI
+)
+
+// ListPendingMatches returns the chart tracks matched with a low confidence which are waiting for the review
+func (playlistServer *PlaylistServer) ListPendingMatches(ctx context.Context, req *proto.ListPendingMatchesRequest) (*proto.ListPendingMatchesResponse, error) {
+	var date sql.NullTime
+	if req.Date != "" {
+		parsedDate, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			slog.Error("[ListPendingMatches] - Invalid date format", "error", err)
+			return nil, fmt.Errorf("invalid date format: %v", err)
+		}
+		date = sql.NullTime{Time: parsedDate, Valid: true}
+	}
+
+	tracks, err := playlistServer.DB.ListPendingTracks(ctx, date)
+	if err != nil {
+		slog.Error("Error listing pending tracks", "error", err)
+		return nil, fmt.Errorf("error listing pending tracks: %v", err)
+	}
+
+	pendingMatches := make([]*proto.PendingMatch, 0, len(tracks))
+	for _, track := range tracks {
+		pendingMatches = append(pendingMatches, convertPendingTrackToProto(track))
+	}
+
+	return &proto.ListPendingMatchesResponse{
+		PendingMatches: pendingMatches,
+	}, nil
+}
+
+// ReviewPendingMatch approves, rejects or replaces the low confidence match of every date.
+// Approved and replaced tracks are saved as the resolved track so that the next charts use the same track without the review
+func (playlistServer *PlaylistServer) ReviewPendingMatch(ctx context.Context, req *proto.ReviewPendingMatchRequest) (*proto.ReviewPendingMatchResponse, error) {
+	if req.MelonTitle == "" || req.MelonArtist == "" || req.Uri == "" {
+		return nil, fmt.Errorf("melon_title, melon_artist and uri are required")
+	}
+
+	var searchedTrack *spotify.Track
+	switch req.Action {
+	case reviewActionApprove, reviewActionReject:
+	case reviewActionReplace:
+		if req.SpotifyURI == "" {
+			return nil, fmt.Errorf("spotifyURI is required to replace the match")
+		}
+
+		var err error
+		searchedTrack, err = spotify.GetTrack(req.SpotifyURI, req.AccessToken)
+		if err != nil || searchedTrack == nil || searchedTrack.URI == "" {
+			slog.Error("Error getting the spotify track", "spotifyURI", req.SpotifyURI, "error", err)
+			return nil, fmt.Errorf("track not found on spotify: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported review action: %s", req.Action)
+	}
+
+	tx, err := playlistServer.DBConn.Begin()
+	if err != nil {
+		slog.Error("Error starting transaction", "error", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	qtx := playlistServer.DB.WithTx(tx)
+
+	var tracksReviewed int64
+	switch req.Action {
+	case reviewActionApprove:
+		tracksReviewed, err = approvePendingTracks(ctx, qtx, req)
+	case reviewActionReject:
+		tracksReviewed, err = rejectPendingTracks(ctx, qtx, req)
+	case reviewActionReplace:
+		tracksReviewed, err = replacePendingTracks(ctx, qtx, req, searchedTrack)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Reviewed pending match", "melonTitle", req.MelonTitle, "melonArtist", req.MelonArtist, "uri", req.Uri, "action", req.Action, "tracksReviewed", tracksReviewed)
+
+	return &proto.ReviewPendingMatchResponse{
+		Status:         fmt.Sprintf("%s %d tracks", req.Action, tracksReviewed),
+		TracksReviewed: int32(tracksReviewed),
+	}, nil
+}
+
+func approvePendingTracks(ctx context.Context, qtx *database.Queries, req *proto.ReviewPendingMatchRequest) (int64, error) {
+	approvedTracks, err := qtx.ApprovePendingTracks(ctx, database.ApprovePendingTracksParams{
+		MelonTitle:  req.MelonTitle,
+		MelonArtist: req.MelonArtist,
+		Uri:         req.Uri,
+	})
+	if err != nil {
+		slog.Error("Error approving pending tracks", "melonTitle", req.MelonTitle, "melonArtist", req.MelonArtist, "error", err)
+		return 0, err
+	}
+	if len(approvedTracks) == 0 {
+		return 0, fmt.Errorf("pending match not found: %s - %s", req.MelonTitle, req.MelonArtist)
+	}
+
+	track := approvedTracks[0]
+	err = saveResolvedMapping(ctx, qtx, database.CreateResolvedTrackParams{
+		MissedTitle:  req.MelonTitle,
+		MissedArtist: req.MelonArtist,
+		Title:        track.Title,
+		Artist:       track.Artist,
+		Uri:          track.Uri,
+		Date:         time.Now(),
+		Album:        track.Album,
+		DurationMs:   track.DurationMs,
+		Isrc:         track.Isrc,
+	})
+	return int64(len(approvedTracks)), err
+}
+
+func rejectPendingTracks(ctx context.Context, qtx *database.Queries, req *proto.ReviewPendingMatchRequest) (int64, error) {
+	removedTracks, err := qtx.DeletePendingTracks(ctx, database.DeletePendingTracksParams{
+		MelonTitle:  req.MelonTitle,
+		MelonArtist: req.MelonArtist,
+		Uri:         req.Uri,
+	})
+	if err != nil {
+		slog.Error("Error deleting pending tracks", "melonTitle", req.MelonTitle, "melonArtist", req.MelonArtist, "error", err)
+		return 0, err
+	}
+	if len(removedTracks) == 0 {
+		return 0, fmt.Errorf("pending match not found: %s - %s", req.MelonTitle, req.MelonArtist)
+	}
+
+	if err = addTracksToMissedTracks(ctx, qtx, req.MelonTitle, req.MelonArtist, removedTracks); err != nil {
+		return 0, err
+	}
+	return int64(len(removedTracks)), nil
+}
+
+func replacePendingTracks(ctx context.Context, qtx *database.Queries, req *proto.ReviewPendingMatchRequest, searchedTrack *spotify.Track) (int64, error) {
+	// The exact track was picked so it's same as resolving with the spotify URI
+	tracksUpdated, err := qtx.ReplacePendingTracks(ctx, database.ReplacePendingTracksParams{
+		Title:           searchedTrack.Name,
+		Artist:          searchedTrack.Artist,
+		NewUri:          searchedTrack.URI,
+		Album:           searchedTrack.Album,
+		DurationMs:      int32(searchedTrack.DurationMs),
+		Isrc:            searchedTrack.ISRC,
+		MatchMethod:     matchMethodManualURI,
+		MatchConfidence: 1,
+		MelonTitle:      req.MelonTitle,
+		MelonArtist:     req.MelonArtist,
+		OldUri:          req.Uri,
+	})
+	if err != nil {
+		slog.Error("Error replacing pending tracks", "melonTitle", req.MelonTitle, "melonArtist", req.MelonArtist, "error", err)
+		return 0, err
+	}
+	if tracksUpdated == 0 {
+		return 0, fmt.Errorf("pending match not found: %s - %s", req.MelonTitle, req.MelonArtist)
+	}
+
+	err = saveResolvedMapping(ctx, qtx, database.CreateResolvedTrackParams{
+		MissedTitle:  req.MelonTitle,
+		MissedArtist: req.MelonArtist,
+		Title:        searchedTrack.Name,
+		Artist:       searchedTrack.Artist,
+		Uri:          searchedTrack.URI,
+		Date:         time.Now(),
+		Album:        searchedTrack.Album,
+		DurationMs:   int32(searchedTrack.DurationMs),
+		Isrc:         searchedTrack.ISRC,
+	})
+	return tracksUpdated, err
+}
+
+// saveResolvedMapping creates the mapping of the melon title and artist or replaces the existing one
+func saveResolvedMapping(ctx context.Context, qtx *database.Queries, params database.CreateResolvedTrackParams) error {
+	_, err := qtx.GetResolvedTrack(ctx, database.GetResolvedTrackParams{
+		MissedTitle:  params.MissedTitle,
+		MissedArtist: params.MissedArtist,
+	})
+	if err == sql.ErrNoRows {
+		_, err = qtx.CreateResolvedTrack(ctx, params)
+	} else if err == nil {
+		_, err = qtx.UpdateResolvedTrack(ctx, database.UpdateResolvedTrackParams{
+			MissedTitle:  params.MissedTitle,
+			MissedArtist: params.MissedArtist,
+			Title:        params.Title,
+			Artist:       params.Artist,
+			Uri:          params.Uri,
+			Album:        params.Album,
+			DurationMs:   params.DurationMs,
+			Isrc:         params.Isrc,
+			Date:         params.Date,
+		})
+	}
+
+	if err != nil {
+		slog.Error("Error saving resolved track", "missedTitle", params.MissedTitle, "missedArtist", params.MissedArtist, "error", err)
+	}
+	return err
+}
+
+func convertPendingTrackToProto(track database.Track) *proto.PendingMatch {
+	return &proto.PendingMatch{
+		Rank:            track.Rank,
+		MelonTitle:      track.MelonTitle,
+		MelonArtist:     track.MelonArtist,
+		Title:           track.Title,
+		Artist:          track.Artist,
+		Uri:             track.Uri,
+		Album:           track.Album,
+		Date:            track.Date.Format("2006-01-02"),
+		MatchConfidence: float64(track.MatchConfidence),
+	}
+}
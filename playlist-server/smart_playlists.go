@@ -0,0 +1,354 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+)
+
+// How the evaluated tracks are written to the playlist
+const (
+	smartPlaylistModeReplace = "replace" // replace the playlist with the evaluated tracks
+	smartPlaylistModeAppend  = "append"  // add the evaluated tracks after the existing ones
+)
+
+// Order of the evaluated tracks. Ties keep the chart order
+const (
+	smartPlaylistSortRank        = "rank"
+	smartPlaylistSortDaysOnChart = "days_on_chart" // longest charting first
+	smartPlaylistSortNewest      = "newest"        // most recent first chart appearance first
+)
+
+const smartPlaylistDescription = "Smart playlist by melongo"
+
+// smartPlaylistRules is the JSON rules of the smart playlist. Every rule is optional
+type smartPlaylistRules struct {
+	Source          string   `json:"source"` // only melon_top100 for now
+	Date            string   `json:"date"`   // chart date (yyyy-mm-dd). The latest saved chart when empty
+	FromRank        int32    `json:"fromRank"`
+	ToRank          int32    `json:"toRank"`
+	IncludeArtists  []string `json:"includeArtists"` // only the tracks by these artists when not empty
+	ExcludeArtists  []string `json:"excludeArtists"`
+	MinDaysOnChart  int32    `json:"minDaysOnChart"`
+	NewEntriesOnly  bool     `json:"newEntriesOnly"` // only the tracks charting for the first time on the date
+	FreshOnly       bool     `json:"freshOnly"`      // skip the tracks already delivered to the user
+	FreshWithinDays int32    `json:"freshWithinDays"`
+	MaxTracks       int32    `json:"maxTracks"`
+	SortBy          string   `json:"sortBy"`
+	Mode            string   `json:"mode"`
+}
+
+// parseSmartPlaylistRules decodes and validates the rules. Unknown rules are rejected so that typos aren't ignored
+func parseSmartPlaylistRules(data []byte) (smartPlaylistRules, error) {
+	var rules smartPlaylistRules
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&rules); err != nil {
+		return smartPlaylistRules{}, fmt.Errorf("invalid rules: %v", err)
+	}
+
+	if rules.Source == "" {
+		rules.Source = chartMelonTop100
+	}
+	if rules.Source != chartMelonTop100 {
+		return smartPlaylistRules{}, fmt.Errorf("unsupported chart source: %s", rules.Source)
+	}
+	if rules.Date != "" {
+		if _, err := time.Parse("2006-01-02", rules.Date); err != nil {
+			return smartPlaylistRules{}, fmt.Errorf("invalid date format: %v", err)
+		}
+	}
+	if rules.FromRank < 0 || rules.ToRank < 0 || rules.MinDaysOnChart < 0 || rules.FreshWithinDays < 0 || rules.MaxTracks < 0 {
+		return smartPlaylistRules{}, fmt.Errorf("fromRank, toRank, minDaysOnChart, freshWithinDays and maxTracks can't be negative")
+	}
+	if rules.FromRank > 0 && rules.ToRank > 0 && rules.FromRank > rules.ToRank {
+		return smartPlaylistRules{}, fmt.Errorf("fromRank %d is greater than toRank %d", rules.FromRank, rules.ToRank)
+	}
+
+	switch rules.SortBy {
+	case "":
+		rules.SortBy = smartPlaylistSortRank
+	case smartPlaylistSortRank, smartPlaylistSortDaysOnChart, smartPlaylistSortNewest:
+	default:
+		return smartPlaylistRules{}, fmt.Errorf("unsupported sortBy: %s", rules.SortBy)
+	}
+
+	switch rules.Mode {
+	case "":
+		rules.Mode = smartPlaylistModeReplace
+	case smartPlaylistModeReplace, smartPlaylistModeAppend:
+	default:
+		return smartPlaylistRules{}, fmt.Errorf("unsupported mode: %s", rules.Mode)
+	}
+
+	return rules, nil
+}
+
+// SaveSmartPlaylist validates and saves the rules. Saving the same name again replaces the rules
+func (playlistServer *PlaylistServer) SaveSmartPlaylist(ctx context.Context, req *proto.SaveSmartPlaylistRequest) (*proto.SaveSmartPlaylistResponse, error) {
+	if req.UserID == "" || req.Name == "" {
+		return nil, fmt.Errorf("userID and name are required")
+	}
+	if _, err := parseSmartPlaylistRules([]byte(req.Rules)); err != nil {
+		return nil, err
+	}
+
+	smartPlaylist, err := playlistServer.DB.UpsertSmartPlaylist(ctx, database.UpsertSmartPlaylistParams{
+		UserID:     req.UserID,
+		Name:       req.Name,
+		PlaylistID: req.PlaylistID,
+		Rules:      json.RawMessage(req.Rules),
+	})
+	if err != nil {
+		slog.Error("Error saving the smart playlist", "userID", req.UserID, "name", req.Name, "error", err)
+		return nil, fmt.Errorf("error saving the smart playlist: %v", err)
+	}
+
+	slog.Info("Saved smart playlist", "userID", req.UserID, "id", smartPlaylist.ID, "name", req.Name)
+
+	return &proto.SaveSmartPlaylistResponse{
+		SmartPlaylist: convertSmartPlaylistToProto(smartPlaylist),
+	}, nil
+}
+
+func (playlistServer *PlaylistServer) ListSmartPlaylists(ctx context.Context, req *proto.ListSmartPlaylistsRequest) (*proto.ListSmartPlaylistsResponse, error) {
+	smartPlaylists, err := playlistServer.DB.ListSmartPlaylists(ctx, req.UserID)
+	if err != nil {
+		slog.Error("Error listing smart playlists", "userID", req.UserID, "error", err)
+		return nil, fmt.Errorf("error listing smart playlists: %v", err)
+	}
+
+	protoSmartPlaylists := make([]*proto.SmartPlaylist, 0, len(smartPlaylists))
+	for _, smartPlaylist := range smartPlaylists {
+		protoSmartPlaylists = append(protoSmartPlaylists, convertSmartPlaylistToProto(smartPlaylist))
+	}
+
+	return &proto.ListSmartPlaylistsResponse{
+		SmartPlaylists: protoSmartPlaylists,
+	}, nil
+}
+
+// DeleteSmartPlaylist removes the rules. The spotify playlist and its tracks are kept
+func (playlistServer *PlaylistServer) DeleteSmartPlaylist(ctx context.Context, req *proto.DeleteSmartPlaylistRequest) (*proto.DeleteSmartPlaylistResponse, error) {
+	smartPlaylist, err := playlistServer.DB.DeleteSmartPlaylist(ctx, database.DeleteSmartPlaylistParams{
+		ID:     req.Id,
+		UserID: req.UserID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("smart playlist not found: %d", req.Id)
+	} else if err != nil {
+		slog.Error("Error deleting the smart playlist", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &proto.DeleteSmartPlaylistResponse{
+		SmartPlaylist: convertSmartPlaylistToProto(smartPlaylist),
+	}, nil
+}
+
+// EvaluateSmartPlaylist runs the rules against the saved chart and its history and writes the matched tracks
+// to the playlist. A new playlist is created on the first evaluation when the smart playlist has none
+func (playlistServer *PlaylistServer) EvaluateSmartPlaylist(ctx context.Context, req *proto.EvaluateSmartPlaylistRequest) (*proto.EvaluateSmartPlaylistResponse, error) {
+	smartPlaylist, err := playlistServer.DB.GetSmartPlaylist(ctx, database.GetSmartPlaylistParams{
+		ID:     req.Id,
+		UserID: req.UserID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("smart playlist not found: %d", req.Id)
+	} else if err != nil {
+		slog.Error("Error getting the smart playlist", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	rules, err := parseSmartPlaylistRules(smartPlaylist.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	date, err := playlistServer.smartPlaylistDate(ctx, req.Date, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks, err := playlistServer.evaluateSmartPlaylistRules(ctx, req.UserID, date, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &proto.EvaluateSmartPlaylistResponse{
+		PlaylistID: smartPlaylist.PlaylistID,
+		Date:       date.Format("2006-01-02"),
+		Tracks:     tracks,
+	}
+	if req.DryRun {
+		response.Status = fmt.Sprintf("Matched %d tracks", len(tracks))
+		return response, nil
+	}
+
+	playlistID := smartPlaylist.PlaylistID
+	if playlistID == "" {
+		newPlaylist, err := spotify.CreateNewPlaylist(smartPlaylist.Name, smartPlaylistDescription, false, req.UserID, req.AccessToken)
+		if err != nil {
+			slog.Error("Error creating the smart playlist", "userID", req.UserID, "name", smartPlaylist.Name, "error", err)
+			return nil, err
+		}
+		playlistID = newPlaylist.SpotifyPlaylistID
+	}
+
+	uris := make([]string, 0, len(tracks))
+	for _, track := range tracks {
+		uris = append(uris, track.Uri)
+	}
+
+	snapshotID, err := playlistServer.writeSmartPlaylist(ctx, req.UserID, playlistID, rules.Mode, uris, req.AccessToken)
+	if err != nil {
+		slog.Error("Error writing the smart playlist", "playlistID", playlistID, "mode", rules.Mode, "error", err)
+		return nil, fmt.Errorf("error writing the smart playlist: %v", err)
+	}
+
+	err = playlistServer.DB.UpdateSmartPlaylistEvaluated(ctx, database.UpdateSmartPlaylistEvaluatedParams{
+		ID:              smartPlaylist.ID,
+		PlaylistID:      playlistID,
+		LastEvaluatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		slog.Error("Error updating the smart playlist evaluation", "id", smartPlaylist.ID, "error", err)
+	}
+
+	slog.Info("Evaluated smart playlist", "id", smartPlaylist.ID, "playlistID", playlistID, "date", response.Date, "tracks", len(uris))
+
+	response.PlaylistID = playlistID
+	response.SnapshotID = snapshotID
+	response.Status = fmt.Sprintf("Matched %d tracks and wrote them to the playlist (%s)", len(tracks), rules.Mode)
+	return response, nil
+}
+
+// writeSmartPlaylist appends the tracks as an operation which can be undone or replaces the playlist after
+// snapshotting it. Returns the snapshot ID of the playlist after the write
+func (playlistServer *PlaylistServer) writeSmartPlaylist(ctx context.Context, userID string, playlistID string, mode string, uris []string, accessToken string) (string, error) {
+	if mode == smartPlaylistModeAppend {
+		playlistOperation, err := playlistServer.addTracksToUserPlaylist(ctx, userID, playlistID, operationSmartPlaylist, uris, accessToken)
+		return playlistOperation.SpotifySnapshotID, err
+	}
+
+	return playlistServer.replaceUserPlaylistTracks(ctx, userID, playlistID, operationSmartPlaylist, uris, accessToken)
+}
+
+// smartPlaylistDate returns the requested date, then the date of the rules and the latest saved chart otherwise
+func (playlistServer *PlaylistServer) smartPlaylistDate(ctx context.Context, requestDate string, rules smartPlaylistRules) (time.Time, error) {
+	dateString := requestDate
+	if dateString == "" {
+		dateString = rules.Date
+	}
+	if dateString != "" {
+		date, err := time.Parse("2006-01-02", dateString)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date format: %v", err)
+		}
+		return date, nil
+	}
+
+	date, err := playlistServer.DB.GetLatestChartDate(ctx)
+	if err != nil {
+		slog.Error("Error getting the latest chart date", "error", err)
+		return time.Time{}, fmt.Errorf("no saved chart to evaluate: %v", err)
+	}
+	return date, nil
+}
+
+// evaluateSmartPlaylistRules filters the chart of the date by the rank and chart history rules in the query
+// and applies the artist, fresh only, sort and max tracks rules afterwards
+func (playlistServer *PlaylistServer) evaluateSmartPlaylistRules(ctx context.Context, userID string, date time.Time, rules smartPlaylistRules) ([]*proto.SmartPlaylistTrack, error) {
+	toRank := rules.ToRank
+	if toRank == 0 {
+		toRank = math.MaxInt32
+	}
+
+	candidates, err := playlistServer.DB.GetSmartPlaylistCandidates(ctx, database.GetSmartPlaylistCandidatesParams{
+		Date:           date,
+		FromRank:       rules.FromRank,
+		ToRank:         toRank,
+		MinDaysOnChart: rules.MinDaysOnChart,
+		NewEntriesOnly: rules.NewEntriesOnly,
+	})
+	if err != nil {
+		slog.Error("Error getting the smart playlist candidates", "date", date, "error", err)
+		return nil, fmt.Errorf("error getting the chart tracks: %v", err)
+	}
+
+	var delivered map[string]bool
+	if rules.FreshOnly {
+		delivered, err = playlistServer.getDeliveredTracks(ctx, userID, rules.FreshWithinDays)
+		if err != nil {
+			slog.Error("Error getting the delivered tracks", "userID", userID, "error", err)
+			return nil, fmt.Errorf("error getting the delivered tracks: %v", err)
+		}
+	}
+
+	includedArtists := newArtistSet(rules.IncludeArtists)
+	excludedArtists := newArtistSet(rules.ExcludeArtists)
+
+	var matched []database.GetSmartPlaylistCandidatesRow
+	for _, candidate := range candidates {
+		artists := append(strings.Split(candidate.Artist, ", "), candidate.MelonArtist)
+		if len(includedArtists) > 0 && !includedArtists.contains(artists) {
+			continue
+		}
+		if excludedArtists.contains(artists) || delivered[candidate.Uri] {
+			continue
+		}
+		matched = append(matched, candidate)
+	}
+
+	switch rules.SortBy {
+	case smartPlaylistSortDaysOnChart:
+		sort.SliceStable(matched, func(i, j int) bool {
+			return matched[i].DaysOnChart > matched[j].DaysOnChart
+		})
+	case smartPlaylistSortNewest:
+		sort.SliceStable(matched, func(i, j int) bool {
+			return matched[i].FirstChartDate.After(matched[j].FirstChartDate)
+		})
+	}
+
+	if rules.MaxTracks > 0 && len(matched) > int(rules.MaxTracks) {
+		matched = matched[:rules.MaxTracks]
+	}
+
+	tracks := make([]*proto.SmartPlaylistTrack, 0, len(matched))
+	for _, track := range matched {
+		tracks = append(tracks, &proto.SmartPlaylistTrack{
+			Rank:           track.Rank,
+			Title:          track.Title,
+			Artist:         track.Artist,
+			Uri:            track.Uri,
+			DaysOnChart:    track.DaysOnChart,
+			FirstChartDate: track.FirstChartDate.Format("2006-01-02"),
+		})
+	}
+	return tracks, nil
+}
+
+func convertSmartPlaylistToProto(smartPlaylist database.SmartPlaylist) *proto.SmartPlaylist {
+	protoSmartPlaylist := &proto.SmartPlaylist{
+		Id:         smartPlaylist.ID,
+		Name:       smartPlaylist.Name,
+		PlaylistID: smartPlaylist.PlaylistID,
+		Rules:      string(smartPlaylist.Rules),
+	}
+	if smartPlaylist.LastEvaluatedAt.Valid {
+		protoSmartPlaylist.LastEvaluatedAt = smartPlaylist.LastEvaluatedAt.Time.Format(time.RFC3339)
+	}
+	return protoSmartPlaylist
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+)
+
+// Operations which write to the user's playlist. Saved with the snapshot taken right before the write
+const (
+	operationMelonTop100     = "melon_top100"
+	operationResolvedTrack   = "resolved_track"
+	operationFavoriteArtists = "favorite_artists"
+	operationReleaseRadar    = "release_radar"
+	operationSubscription    = "subscription_" // followed by the subscription policy
+	operationSmartPlaylist   = "smart_playlist"
+	operationTrackListImport = "track_list_import"
+	operationMergePlaylists  = "merge_playlists"
+	operationDedupePlaylist  = "dedupe_playlist"
+	operationRemoveTracks    = "remove_tracks"
+	operationReorderTracks   = "reorder_tracks"
+	operationRestore         = "restore"
+	operationUndo            = "undo"
+)
+
+// Number of the newest snapshots kept for each playlist
+const playlistSnapshotsKept = 50
+
+// snapshotPlaylist saves the current tracks of the playlist before melongo writes to it.
+// The write should be skipped when the snapshot fails so that every write can be rolled back
+func (playlistServer *PlaylistServer) snapshotPlaylist(ctx context.Context, userID string, playlistID string, operation string, accessToken string) (database.PlaylistSnapshot, error) {
+	current, err := spotify.GetPlaylistSnapshot(playlistID, accessToken)
+	if err != nil {
+		slog.Error("Error getting the playlist to snapshot", "playlistID", playlistID, "operation", operation, "error", err)
+		return database.PlaylistSnapshot{}, fmt.Errorf("error getting the playlist to snapshot: %v", err)
+	}
+
+	return playlistServer.savePlaylistSnapshot(ctx, userID, playlistID, operation, current)
+}
+
+// savePlaylistSnapshot saves the already fetched tracks of the playlist as its snapshot.
+// Used when the current tracks are needed to decide whether to write at all
+func (playlistServer *PlaylistServer) savePlaylistSnapshot(ctx context.Context, userID string, playlistID string, operation string, current spotify.PlaylistSnapshot) (database.PlaylistSnapshot, error) {
+	snapshot, err := playlistServer.DB.CreatePlaylistSnapshot(ctx, database.CreatePlaylistSnapshotParams{
+		UserID:            userID,
+		PlaylistID:        playlistID,
+		SpotifySnapshotID: current.SnapshotID,
+		// uris can't be null for an empty playlist
+		Uris:      append([]string{}, current.URIs...),
+		Operation: operation,
+	})
+	if err != nil {
+		slog.Error("Error saving the playlist snapshot", "playlistID", playlistID, "operation", operation, "error", err)
+		return database.PlaylistSnapshot{}, fmt.Errorf("error saving the playlist snapshot: %v", err)
+	}
+
+	_, err = playlistServer.DB.DeleteOldPlaylistSnapshots(ctx, database.DeleteOldPlaylistSnapshotsParams{
+		PlaylistID: playlistID,
+		Keep:       playlistSnapshotsKept,
+	})
+	if err != nil {
+		slog.Error("Error deleting the old playlist snapshots", "playlistID", playlistID, "error", err)
+	}
+
+	return snapshot, nil
+}
+
+// ListPlaylistSnapshots returns the snapshots of the user's playlist, newest first
+func (playlistServer *PlaylistServer) ListPlaylistSnapshots(ctx context.Context, req *proto.ListPlaylistSnapshotsRequest) (*proto.ListPlaylistSnapshotsResponse, error) {
+	if req.PlaylistID == "" {
+		return nil, fmt.Errorf("playlistID is required")
+	}
+
+	snapshots, err := playlistServer.DB.ListPlaylistSnapshots(ctx, database.ListPlaylistSnapshotsParams{
+		UserID:     req.UserID,
+		PlaylistID: req.PlaylistID,
+	})
+	if err != nil {
+		slog.Error("Error listing playlist snapshots", "playlistID", req.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error listing playlist snapshots: %v", err)
+	}
+
+	protoSnapshots := make([]*proto.PlaylistSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		protoSnapshots = append(protoSnapshots, convertPlaylistSnapshotToProto(snapshot))
+	}
+
+	return &proto.ListPlaylistSnapshotsResponse{
+		Snapshots: protoSnapshots,
+	}, nil
+}
+
+// RestorePlaylistSnapshot replaces the tracks of the playlist with the tracks of the snapshot.
+// The playlist is snapshotted again first so that the restore itself can be reverted
+func (playlistServer *PlaylistServer) RestorePlaylistSnapshot(ctx context.Context, req *proto.RestorePlaylistSnapshotRequest) (*proto.RestorePlaylistSnapshotResponse, error) {
+	snapshot, err := playlistServer.DB.GetPlaylistSnapshot(ctx, database.GetPlaylistSnapshotParams{
+		ID:     req.Id,
+		UserID: req.UserID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("playlist snapshot not found: %d", req.Id)
+	} else if err != nil {
+		slog.Error("Error getting the playlist snapshot", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	backup, err := playlistServer.snapshotPlaylist(ctx, req.UserID, snapshot.PlaylistID, operationRestore, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unavailable tracks and local files can't be added through the spotify API
+	uris := make([]string, 0, len(snapshot.Uris))
+	for _, uri := range snapshot.Uris {
+		if uri != "" && !strings.HasPrefix(uri, "spotify:local:") {
+			uris = append(uris, uri)
+		}
+	}
+
+	response, err := spotify.ReplacePlaylistTracks(snapshot.PlaylistID, uris, req.AccessToken)
+	if err != nil {
+		slog.Error("Error restoring the playlist snapshot", "id", snapshot.ID, "playlistID", snapshot.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error restoring the playlist: %v", err)
+	}
+
+	slog.Info("Restored playlist snapshot", "id", snapshot.ID, "playlistID", snapshot.PlaylistID, "tracks", len(uris))
+
+	status := fmt.Sprintf("Restored %d tracks from %s", len(uris), snapshot.CreatedAt.Format(time.RFC3339))
+	if skipped := len(snapshot.Uris) - len(uris); skipped > 0 {
+		status += fmt.Sprintf(". %d unavailable tracks or local files couldn't be restored", skipped)
+	}
+
+	return &proto.RestorePlaylistSnapshotResponse{
+		Status:            status,
+		RestoredTracks:    int32(len(uris)),
+		SpotifySnapshotID: response.SnapshotID,
+		BackupSnapshotID:  backup.ID,
+	}, nil
+}
+
+func convertPlaylistSnapshotToProto(snapshot database.PlaylistSnapshot) *proto.PlaylistSnapshot {
+	return &proto.PlaylistSnapshot{
+		Id:                snapshot.ID,
+		PlaylistID:        snapshot.PlaylistID,
+		SpotifySnapshotID: snapshot.SpotifySnapshotID,
+		Uris:              snapshot.Uris,
+		Operation:         snapshot.Operation,
+		CreatedAt:         snapshot.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,114 @@
+package spotify
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MatchReviewThreshold is the lowest match confidence of the searched track to be used without the review
+const MatchReviewThreshold = 0.6
+
+// MatchConfidence scores how close the spotify track is to the given melon title and artist
+// returns a value between 0 (no similarity) and 1 (exact match)
+func MatchConfidence(title, artist string, track *Track) float64 {
+	if track == nil {
+		return 0
+	}
+
+	titleScore := Similarity(formatTitle(title), formatTitle(track.Name))
+
+	artists := track.Artists
+	if len(artists) == 0 && track.Artist != "" {
+		artists = strings.Split(track.Artist, ", ")
+	}
+
+	// Melon artist can be "Korean name (English name)" so try every form of it
+	melonArtists := []string{artist, formatArtistName(artist)}
+	if idx := strings.Index(artist, "("); idx > 0 {
+		melonArtists = append(melonArtists, artist[:idx])
+	}
+
+	artistScore := 0.0
+	comparable := false
+	for _, spotifyArtist := range artists {
+		for _, melonArtist := range melonArtists {
+			if !sameScript(melonArtist, spotifyArtist) {
+				continue
+			}
+			comparable = true
+			if score := Similarity(melonArtist, spotifyArtist); score > artistScore {
+				artistScore = score
+			}
+		}
+	}
+
+	// Korean artist name on melon and English name on spotify (or the other way around)
+	// can't be compared, so only rely on the title with a small penalty
+	if !comparable {
+		return titleScore * 0.85
+	}
+
+	return titleScore*0.6 + artistScore*0.4
+}
+
+// Similarity returns the normalized levenshtein similarity of two strings between 0 and 1
+// case, spaces and punctuation are ignored
+func Similarity(a, b string) float64 {
+	ra := []rune(normalize(a))
+	rb := []rune(normalize(b))
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	maxLen := len(ra)
+	if len(rb) > maxLen {
+		maxLen = len(rb)
+	}
+	score := 1 - float64(levenshtein(ra, rb))/float64(maxLen)
+
+	// ex) "Supernova" and "Supernova - Sped Up"
+	if score < 0.9 && (strings.Contains(string(ra), string(rb)) || strings.Contains(string(rb), string(ra))) {
+		score = 0.9
+	}
+	return score
+}
+
+// normalize lower cases the string and removes everything other than letters and numbers
+func normalize(s string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// sameScript checks if both strings can be compared with each other (both have korean or both have english)
+func sameScript(a, b string) bool {
+	return (containsKorean(a) && containsKorean(b)) || (containsEnglish(a) && containsEnglish(b))
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
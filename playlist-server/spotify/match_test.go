@@ -0,0 +1,187 @@
+package spotify
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{name: "identical", a: "Supernova", b: "Supernova", want: 1},
+		{name: "case, spaces and punctuation are ignored", a: "How Sweet!", b: "how sweet", want: 1},
+		{name: "both empty", a: "", b: "", want: 1},
+		{name: "one empty", a: "Supernova", b: "", want: 0},
+		{name: "one letter off", a: "abcde", b: "abcdx", want: 0.8},
+		{name: "nothing in common", a: "abc", b: "xyz", want: 0},
+		{name: "containing the other is at least 0.9", a: "Supernova", b: "Supernova - Sped Up", want: 0.9},
+		{name: "hangul", a: "밤양갱", b: "밤양갱", want: 1},
+		{name: "hangul and latin can't be compared", a: "밤양갱", b: "Bam Yang Gang", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Similarity(tt.a, tt.b); !almostEqual(got, tt.want) {
+				t.Errorf("Similarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchConfidence(t *testing.T) {
+	tests := []struct {
+		name   string
+		title  string
+		artist string
+		track  *Track
+		want   float64
+	}{
+		{
+			name:   "exact match",
+			title:  "Supernova",
+			artist: "aespa",
+			track:  &Track{Name: "Supernova", Artists: []string{"aespa"}},
+			want:   1,
+		},
+		{
+			name:   "no track",
+			title:  "Supernova",
+			artist: "aespa",
+			track:  nil,
+			want:   0,
+		},
+		{
+			name:   "feat. in the melon title is stripped",
+			title:  "Love wins all (feat. V)",
+			artist: "IU",
+			track:  &Track{Name: "Love wins all", Artists: []string{"IU"}},
+			want:   1,
+		},
+		{
+			name:   "parenthetical in the spotify title is stripped",
+			title:  "Magnetic",
+			artist: "ILLIT",
+			track:  &Track{Name: "Magnetic (Sped Up Ver.)", Artists: []string{"ILLIT"}},
+			want:   1,
+		},
+		{
+			name:   "english name in the brackets of the melon artist",
+			title:  "Love wins all",
+			artist: "아이유 (IU)",
+			track:  &Track{Name: "Love wins all", Artists: []string{"IU"}},
+			want:   1,
+		},
+		{
+			name:   "korean name in front of the brackets of the melon artist",
+			title:  "Love wins all",
+			artist: "아이유 (IU)",
+			track:  &Track{Name: "Love wins all", Artists: []string{"아이유"}},
+			want:   1,
+		},
+		{
+			name:   "hangul artist and latin artist only rely on the title",
+			title:  "Love wins all",
+			artist: "아이유",
+			track:  &Track{Name: "Love wins all", Artists: []string{"IU"}},
+			want:   0.85,
+		},
+		{
+			name:   "hangul title and latin title",
+			title:  "밤양갱",
+			artist: "비비 (BIBI)",
+			track:  &Track{Name: "Bam Yang Gang", Artists: []string{"BIBI"}},
+			want:   0.4,
+		},
+		{
+			name:   "artists joined by comma are split",
+			title:  "Supernova",
+			artist: "aespa",
+			track:  &Track{Name: "Supernova", Artist: "Someone, aespa"},
+			want:   1,
+		},
+		{
+			name:   "same title by a different artist",
+			title:  "Supernova",
+			artist: "aespa",
+			track:  &Track{Name: "Supernova", Artists: []string{"xyz"}},
+			want:   0.6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchConfidence(tt.title, tt.artist, tt.track); !almostEqual(got, tt.want) {
+				t.Errorf("MatchConfidence(%q, %q) = %v, want %v", tt.title, tt.artist, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMatchConfidenceThreshold checks which side of the review threshold the matches fall on
+func TestMatchConfidenceThreshold(t *testing.T) {
+	tests := []struct {
+		name   string
+		title  string
+		artist string
+		track  *Track
+		passes bool
+	}{
+		{
+			// 1*0.6 + 0*0.4 is exactly the threshold
+			name:   "same title by a different artist is exactly at the threshold",
+			title:  "abcde",
+			artist: "abc",
+			track:  &Track{Name: "abcde", Artists: []string{"xyz"}},
+			passes: true,
+		},
+		{
+			// 0.8*0.6 + 0.4*0.4 = 0.64
+			name:   "one letter off the title and a close artist is just above the threshold",
+			title:  "abcde",
+			artist: "abcde",
+			track:  &Track{Name: "abcdx", Artists: []string{"abxyz"}},
+			passes: true,
+		},
+		{
+			// 0.8*0.6 + 0.2*0.4 = 0.56
+			name:   "one letter off the title and a different artist is below the threshold",
+			title:  "abcde",
+			artist: "abcde",
+			track:  &Track{Name: "abcdx", Artists: []string{"axxxx"}},
+			passes: false,
+		},
+		{
+			// 0.7*0.85 = 0.595
+			name:   "hangul and latin artists with a title 0.7 similar is just below the threshold",
+			title:  "abcdefghij",
+			artist: "아이유",
+			track:  &Track{Name: "abcdefgxyz", Artists: []string{"IU"}},
+			passes: false,
+		},
+		{
+			// 0.8*0.85 = 0.68
+			name:   "hangul and latin artists with a title 0.8 similar is above the threshold",
+			title:  "abcde",
+			artist: "아이유",
+			track:  &Track{Name: "abcdx", Artists: []string{"IU"}},
+			passes: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MatchConfidence(tt.title, tt.artist, tt.track)
+			if passes := got >= MatchReviewThreshold; passes != tt.passes {
+				t.Errorf("MatchConfidence(%q, %q) = %v, passes the threshold = %v, want %v", tt.title, tt.artist, got, passes, tt.passes)
+			}
+		})
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
@@ -8,35 +8,69 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 	"unicode"
 )
 
+// httpClient is used for every request to spotify
+var httpClient = &http.Client{}
+
+// SetHTTPClient replaces the client used for the requests to spotify
+// ex) to replay the recorded spotify responses offline
+func SetHTTPClient(client *http.Client) {
+	httpClient = client
+}
+
 type Image struct {
 	Url    string `json:"url"`
 	Height int    `json:"height"`
 	Width  int    `json:"width"`
 }
 
-// Playlist - for getting detailed information of a single playlist
+// Playlist - a single playlist with every track (see GetPlaylist)
 type Playlist struct {
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	ID          string  `json:"id"`
-	Images      []Image `json:"images"`
-	Tracks      struct {
+	ID            string       `json:"id"`
+	Name          string       `json:"name"`
+	Description   string       `json:"description"`
+	Images        []Image      `json:"images"`
+	ExternalURLs  ExternalURLs `json:"external_urls"`
+	SnapshotID    string       `json:"snapshot_id"`
+	Public        bool         `json:"public"`
+	Collaborative bool         `json:"collaborative"`
+	Owner         struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"display_name"`
+	} `json:"owner"`
+	Followers struct {
 		Total int `json:"total"`
-		Item  []struct {
-			Track struct {
-				Artists    []Artist `json:"artists"`
-				Name       string   `json:"name"`
-				Popularity int      `json:"popularity"`
-				URI        string   `json:"uri"`
-			} `json:"track"`
-		} `json:"items"`
+	} `json:"followers"`
+	Tracks struct {
+		Total int                  `json:"total"`
+		Next  string               `json:"next"`
+		Items []PlaylistDetailItem `json:"items"`
 	} `json:"tracks"`
 }
 
+// PlaylistDetailItem - a track of the playlist with the availability in the user's market
+type PlaylistDetailItem struct {
+	AddedAt string `json:"added_at"`
+	IsLocal bool   `json:"is_local"`
+	// nil when the track is no longer available
+	Track *struct {
+		Name       string   `json:"name"`
+		URI        string   `json:"uri"`
+		DurationMs int      `json:"duration_ms"`
+		Popularity int      `json:"popularity"`
+		IsPlayable *bool    `json:"is_playable"` // only set when the market is given
+		Artists    []Artist `json:"artists"`
+		Album      struct {
+			Name   string  `json:"name"`
+			Images []Image `json:"images"`
+		} `json:"album"`
+	} `json:"track"`
+}
+
 // SimplifiedPlaylist - Used when fetching all the playlists of the user
 type SimplifiedPlaylist struct {
 	Next  string     `json:"next"`  // URL to the next page of items
@@ -79,11 +113,20 @@ type Track struct {
 	URI        string `json:"uri"`
 	Name       string `json:"name"`
 	Popularity int    `json:"popularity"`
+	DurationMs int    `json:"duration_ms"`
+	// Filled in from the search response (not part of the spotify track json)
+	Artists       []string `json:"-"`
+	ArtistIDs     []string `json:"-"`
+	Album         string   `json:"-"`
+	AlbumImageURL string   `json:"-"`
+	ISRC          string   `json:"-"`
+	PreviewURL    string   `json:"preview_url"`
 }
 
 // Contains artist names in an array (in case there are more than one)
 type AlbumTrack struct {
 	Artist []struct {
+		ID   string `json:"id"`
 		Name string `json:"name"`
 	} `json:"artists"`
 	URI  string `json:"uri"`
@@ -93,17 +136,30 @@ type AlbumTrack struct {
 // SearchResponse - result of query search by title and artist
 type SearchResponse struct {
 	Tracks struct {
-		Items []struct {
-			Name    string `json:"name"`
-			Artists []struct {
-				Name string `json:"name"`
-			} `json:"artists"`
-			URI        string `json:"uri"`
-			Popularity int    `json:"popularity"`
-		} `json:"items"`
+		Items []SearchTrackItem `json:"items"`
 	} `json:"tracks"`
 }
 
+// SearchTrackItem - a single track from the search result
+type SearchTrackItem struct {
+	Name    string `json:"name"`
+	Artists []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"artists"`
+	Album struct {
+		Name   string  `json:"name"`
+		Images []Image `json:"images"`
+	} `json:"album"`
+	ExternalIDs struct {
+		ISRC string `json:"isrc"`
+	} `json:"external_ids"`
+	URI        string `json:"uri"`
+	Popularity int    `json:"popularity"`
+	DurationMs int    `json:"duration_ms"`
+	PreviewURL string `json:"preview_url"`
+}
+
 type SearchResponseAlbum struct {
 	Albums struct {
 		Items []struct {
@@ -163,7 +219,35 @@ type NewPlaylistResponse struct {
 // AddTrackRequest - request to add new track(s) to the playlist
 type AddTrackRequest struct {
 	URIs     []string `json:"uris"`
-	Position int      `json:"position"`
+	Position *int     `json:"position,omitempty"` // tracks are appended to the end of the playlist when nil
+}
+
+// RemoveTrackRequest - body of the remove items request. Every occurrence of the uri is removed unless
+// the positions are given. Positions are only checked against the playlist of the snapshotID
+type RemoveTrackRequest struct {
+	Tracks     []RemoveTrack `json:"tracks"`
+	SnapshotID string        `json:"snapshot_id,omitempty"`
+}
+
+type RemoveTrack struct {
+	URI       string `json:"uri"`
+	Positions []int  `json:"positions,omitempty"`
+}
+
+// UpdatePlaylistDetailsRequest - details of the playlist to change. nil fields are left unchanged
+type UpdatePlaylistDetailsRequest struct {
+	Name          *string `json:"name,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Public        *bool   `json:"public,omitempty"`
+	Collaborative *bool   `json:"collaborative,omitempty"` // the playlist must be private to be collaborative
+}
+
+// ReorderTracksRequest - moves range_length tracks from range_start to right before insert_before
+type ReorderTracksRequest struct {
+	RangeStart   int    `json:"range_start"`
+	InsertBefore int    `json:"insert_before"`
+	RangeLength  int    `json:"range_length"`
+	SnapshotID   string `json:"snapshot_id,omitempty"`
 }
 
 // AddTrackResponse - returns new id of the playlist
@@ -171,22 +255,40 @@ type AddTrackResponse struct {
 	SnapshotID string `json:"snapshot_id"`
 }
 
-// TODO - need  to check
-func GetPlaylistDetails(accessToken string, userId string) (*Playlist, error) {
-	// Prepare request
-	address := fmt.Sprintf("https://api.spotify.com/v1/users/%s/playlists", userId)
+// GetPlaylist - returns the playlist with every track (follows all the pages of the tracks)
+// market=from_token fills in is_playable of each track for the user's country
+func GetPlaylist(playlistID string, accessToken string) (*Playlist, error) {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s?market=from_token", playlistID)
 	body, err := makeSpotifyGetRequest(address, accessToken)
 	if err != nil {
 		return nil, err
 	}
 
-	var playlistResponse *Playlist
-	err = json.Unmarshal(body, &playlistResponse)
-	if err != nil {
+	var playlist *Playlist
+	if err := json.Unmarshal(body, &playlist); err != nil {
 		return nil, err
 	}
 
-	return playlistResponse, nil
+	next := playlist.Tracks.Next
+	for next != "" {
+		body, err := makeSpotifyGetRequest(next, accessToken)
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			Next  string               `json:"next"`
+			Items []PlaylistDetailItem `json:"items"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, err
+		}
+		playlist.Tracks.Items = append(playlist.Tracks.Items, page.Items...)
+		next = page.Next
+	}
+	playlist.Tracks.Next = ""
+
+	return playlist, nil
 }
 
 // GetUserPlaylists gets all the current user's playlist
@@ -209,13 +311,19 @@ func GetUserPlaylists(accessToken string) (*SimplifiedPlaylist, error) {
 
 type PlaylistTracksResponse struct {
 	Items []struct {
+		// Zero value when the track is no longer available
 		Track struct {
-			Artists    []Artist `json:"artists"`
-			Name       string   `json:"name"`
-			Popularity int      `json:"popularity"`
-			URI        string   `json:"uri"`
+			Artists     []Artist `json:"artists"`
+			Name        string   `json:"name"`
+			Popularity  int      `json:"popularity"`
+			URI         string   `json:"uri"`
+			IsLocal     bool     `json:"is_local"`
+			ExternalIDs struct {
+				ISRC string `json:"isrc"`
+			} `json:"external_ids"`
 		} `json:"track"`
 	} `json:"items"`
+	Next string `json:"next"` // URL of the next page. Empty on the last page
 }
 
 func GetUserPlaylistTracks(accessToken string, address string) (*PlaylistTracksResponse, error) {
@@ -233,6 +341,58 @@ func GetUserPlaylistTracks(accessToken string, address string) (*PlaylistTracksR
 	return playlistResponse, nil
 }
 
+// PlaylistItem - a track of the playlist with the time it was added
+type PlaylistItem struct {
+	AddedAt string           `json:"added_at"`
+	Track   *SearchTrackItem `json:"track"` // nil when the track is no longer available
+}
+
+// GetPlaylistName - returns the name of the playlist
+func GetPlaylistName(playlistID string, accessToken string) (string, error) {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s?fields=name", playlistID)
+	body, err := makeSpotifyGetRequest(address, accessToken)
+	if err != nil {
+		return "", err
+	}
+
+	var playlist struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(body, &playlist); err != nil {
+		return "", err
+	}
+
+	return playlist.Name, nil
+}
+
+// GetPlaylistItemPages - calls handlePage with every page of the playlist items in order (follows all the pages)
+// so that large playlists can be processed without keeping every item in memory
+func GetPlaylistItemPages(playlistID string, accessToken string, handlePage func(items []PlaylistItem) error) error {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s/tracks?limit=100&fields=next,items(added_at,track(name,uri,duration_ms,artists(name),album(name),external_ids))", playlistID)
+
+	for address != "" {
+		body, err := makeSpotifyGetRequest(address, accessToken)
+		if err != nil {
+			return err
+		}
+
+		var page struct {
+			Next  string         `json:"next"`
+			Items []PlaylistItem `json:"items"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return err
+		}
+
+		if err := handlePage(page.Items); err != nil {
+			return err
+		}
+		address = page.Next
+	}
+
+	return nil
+}
+
 func SearchArtistID(artistName string, accessToken string) (ArtistItem, error) {
 	encodedArtistName := url.QueryEscape(artistName)
 	// Construct the search query for the artist
@@ -261,9 +421,63 @@ func SearchArtistID(artistName string, accessToken string) (ArtistItem, error) {
 	return artist, nil
 }
 
+// GetFollowedArtists - returns every artist followed by the user (requires the user-follow-read scope)
+func GetFollowedArtists(accessToken string) ([]ArtistItem, error) {
+	address := "https://api.spotify.com/v1/me/following?type=artist&limit=50"
+
+	var artists []ArtistItem
+	for address != "" {
+		body, err := makeSpotifyGetRequest(address, accessToken)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			Artists struct {
+				Items []ArtistItem `json:"items"`
+				Next  string       `json:"next"`
+			} `json:"artists"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, err
+		}
+
+		artists = append(artists, response.Artists.Items...)
+		address = response.Artists.Next
+	}
+
+	return artists, nil
+}
+
+// GetTopArtists - returns the user's top artists (requires the user-top-read scope)
+func GetTopArtists(accessToken string) ([]ArtistItem, error) {
+	address := "https://api.spotify.com/v1/me/top/artists?limit=50"
+
+	var artists []ArtistItem
+	for address != "" {
+		body, err := makeSpotifyGetRequest(address, accessToken)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			Items []ArtistItem `json:"items"`
+			Next  string       `json:"next"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, err
+		}
+
+		artists = append(artists, response.Items...)
+		address = response.Next
+	}
+
+	return artists, nil
+}
+
 // SearchTrack looks up a music by title and artist
 // returns the Track which contains the URI of the track
-// which can be used to add to the playlist
+// which can be used to add to the playlist along with the metadata of the matched spotify track
 func SearchTrack(title, artist, accessToken string) (*Track, error) {
 	if title == "" || artist == "" || accessToken == "" {
 		return nil, fmt.Errorf("title, artist, or access token is empty")
@@ -307,16 +521,182 @@ func SearchTrack(title, artist, accessToken string) (*Track, error) {
 		return nil, fmt.Errorf("no tracks found for title: %s, artist: %s", title, artist)
 	}
 
-	// Return the URI of the first matching track
-	trackURI := searchResp.Tracks.Items[0].URI
-	popularity := searchResp.Tracks.Items[0].Popularity
+	// Return the first matching track
+	return searchTrackItemToTrack(searchResp.Tracks.Items[0]), nil
+}
+
+// searchTrackItemToTrack converts the track from the search response to a Track
+func searchTrackItemToTrack(item SearchTrackItem) *Track {
+	artists := make([]string, 0, len(item.Artists))
+	artistIDs := make([]string, 0, len(item.Artists))
+	for _, a := range item.Artists {
+		artists = append(artists, a.Name)
+		artistIDs = append(artistIDs, a.ID)
+	}
+
+	var albumImageURL string
+	if len(item.Album.Images) > 0 {
+		albumImageURL = item.Album.Images[0].Url
+	}
 
 	return &Track{
-		Artist:     artist,
-		Name:       formattedTitle,
-		URI:        trackURI,
-		Popularity: popularity,
-	}, nil
+		Artist:        strings.Join(artists, ", "),
+		Artists:       artists,
+		ArtistIDs:     artistIDs,
+		Name:          item.Name,
+		Album:         item.Album.Name,
+		AlbumImageURL: albumImageURL,
+		ISRC:          item.ExternalIDs.ISRC,
+		URI:           item.URI,
+		Popularity:    item.Popularity,
+		DurationMs:    item.DurationMs,
+		PreviewURL:    item.PreviewURL,
+	}
+}
+
+// GetTrack looks up a single track by the spotify track URI, URL or ID
+// returns an error if the track doesn't exist on spotify
+func GetTrack(trackURI string, accessToken string) (*Track, error) {
+	trackID, err := ParseTrackID(trackURI)
+	if err != nil {
+		return nil, err
+	}
+
+	address := fmt.Sprintf("https://api.spotify.com/v1/tracks/%s", trackID)
+	body, err := makeSpotifyGetRequest(address, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up the track %s: %v", trackID, err)
+	}
+
+	// Track object has the same shape as the track in the search response
+	var item SearchTrackItem
+	if err := json.Unmarshal(body, &item); err != nil {
+		return nil, err
+	}
+
+	if item.URI == "" {
+		return nil, fmt.Errorf("track not found: %s", trackID)
+	}
+
+	return searchTrackItemToTrack(item), nil
+}
+
+// ParseTrackID extracts the track ID from the spotify track URI, URL or the ID itself
+// ex) "spotify:track:4uLU6hMCjMI75M1A2tKUQC", "https://open.spotify.com/track/4uLU6hMCjMI75M1A2tKUQC?si=..."
+func ParseTrackID(trackURI string) (string, error) {
+	trackURI = strings.TrimSpace(trackURI)
+
+	var trackID string
+	switch {
+	case strings.HasPrefix(trackURI, "spotify:track:"):
+		trackID = strings.TrimPrefix(trackURI, "spotify:track:")
+	case strings.HasPrefix(trackURI, "http://") || strings.HasPrefix(trackURI, "https://"):
+		parsedURL, err := url.Parse(trackURI)
+		if err != nil {
+			return "", fmt.Errorf("invalid spotify track url: %s", trackURI)
+		}
+		// Path can contain the locale. ex) /intl-ko/track/{id}
+		parts := strings.Split(strings.Trim(parsedURL.Path, "/"), "/")
+		if len(parts) < 2 || parts[len(parts)-2] != "track" {
+			return "", fmt.Errorf("not a spotify track url: %s", trackURI)
+		}
+		trackID = parts[len(parts)-1]
+	default:
+		trackID = trackURI
+	}
+
+	if !isSpotifyID(trackID) {
+		return "", fmt.Errorf("invalid spotify track id: %s", trackID)
+	}
+	return trackID, nil
+}
+
+// isSpotifyID checks if the id is the base62 spotify id
+func isSpotifyID(id string) bool {
+	if len(id) != 22 {
+		return false
+	}
+	for _, r := range id {
+		if !(r >= '0' && r <= '9') && !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// TrackCandidate - possible spotify track for a melon song with the similarity score to the melon song
+type TrackCandidate struct {
+	Track
+	Score float64
+}
+
+// SearchTrackCandidates returns up to limit spotify tracks that could be the given melon song
+// sorted by the similarity score (highest first).
+// Unlike SearchTrack, it also runs looser queries so that the correct track can be picked by the user
+func SearchTrackCandidates(title, artist string, limit int, accessToken string) ([]TrackCandidate, error) {
+	if title == "" || accessToken == "" {
+		return nil, fmt.Errorf("title or access token is empty")
+	}
+
+	formattedTitle := formatTitle(title)
+	formattedArtist := formatArtistName(artist)
+
+	// From the strictest to the loosest query
+	queries := []string{
+		fmt.Sprintf("track:%s artist:%s", formattedTitle, formattedArtist),
+		fmt.Sprintf("%s %s", formattedTitle, formattedArtist),
+		formattedTitle,
+	}
+
+	seen := make(map[string]bool)
+	var candidates []TrackCandidate
+	for _, query := range queries {
+		items, err := searchTracks(query, limit, accessToken)
+		if err != nil {
+			slog.Error("Error searching candidates", "query", query, "error", err)
+			continue
+		}
+
+		for _, item := range items {
+			if item.URI == "" || seen[item.URI] {
+				continue
+			}
+			seen[item.URI] = true
+
+			track := searchTrackItemToTrack(item)
+			candidates = append(candidates, TrackCandidate{
+				Track: *track,
+				Score: MatchConfidence(title, artist, track),
+			})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	return candidates, nil
+}
+
+// searchTracks runs the spotify track search with the query and returns at most limit tracks
+func searchTracks(query string, limit int, accessToken string) ([]SearchTrackItem, error) {
+	searchURL := fmt.Sprintf("https://api.spotify.com/v1/search?q=%s&type=track&limit=%d", url.QueryEscape(query), limit)
+
+	body, err := makeSpotifyGetRequest(searchURL, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var searchResp SearchResponse
+	if err := json.Unmarshal(body, &searchResp); err != nil {
+		return nil, err
+	}
+
+	return searchResp.Tracks.Items, nil
 }
 
 func SearchTracksFromAlbum(albumName, artistName, accessToken string) ([]AlbumTrack, error) {
@@ -431,13 +811,18 @@ func CreateNewPlaylist(name string, description string, isPublic bool, userId st
 // AddTrackToPlaylist - adds trackURI which is comma separated track uris to the playlist
 // TODO - Try to prevent duplicate tracks by checking the existing playlist or maybe store something in the database
 func AddTrackToPlaylist(playlistID string, trackURI []string, accessToken string) (AddTrackResponse, error) {
-	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s/tracks", playlistID)
+	return addTrackToPlaylist(playlistID, AddTrackRequest{URIs: trackURI}, accessToken)
+}
 
-	addTrackRequest := AddTrackRequest{
-		URIs: trackURI,
-	}
+// AddTrackToPlaylistAt - inserts the tracks to the playlist starting at the position (0 is the first track)
+func AddTrackToPlaylistAt(playlistID string, trackURI []string, position int, accessToken string) (AddTrackResponse, error) {
+	return addTrackToPlaylist(playlistID, AddTrackRequest{URIs: trackURI, Position: &position}, accessToken)
+}
+
+func addTrackToPlaylist(playlistID string, addTrackRequest AddTrackRequest, accessToken string) (AddTrackResponse, error) {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s/tracks", playlistID)
 
-	slog.Info("TrackURI: ", len(trackURI))
+	slog.Info("Adding tracks to the playlist", "playlistID", playlistID, "tracks", len(addTrackRequest.URIs), "position", addTrackRequest.Position)
 
 	body, err := json.Marshal(addTrackRequest)
 	if err != nil {
@@ -460,6 +845,292 @@ func AddTrackToPlaylist(playlistID string, trackURI []string, accessToken string
 	return response, nil
 }
 
+// maxTracksPerRequest is the most tracks spotify accepts in a single add, replace or remove request
+const maxTracksPerRequest = 100
+
+// ReplacePlaylistTracks - replaces every track of the playlist with the trackURI
+// spotify replaces at most 100 tracks at once so the rest are appended afterwards
+func ReplacePlaylistTracks(playlistID string, trackURI []string, accessToken string) (AddTrackResponse, error) {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s/tracks", playlistID)
+
+	slog.Info("Replacing tracks of the playlist", "playlistID", playlistID, "tracks", len(trackURI))
+
+	first := trackURI
+	if len(first) > maxTracksPerRequest {
+		first = first[:maxTracksPerRequest]
+	}
+
+	// uris must be an empty array (not null) to clear the playlist
+	body, err := json.Marshal(AddTrackRequest{URIs: append([]string{}, first...)})
+	if err != nil {
+		slog.Error("Error during json.Marshal")
+		return AddTrackResponse{}, err
+	}
+
+	body, err = makeSpotifyRequest("PUT", address, body, accessToken)
+	if err != nil {
+		slog.Error("Error making the request to the spotify")
+		return AddTrackResponse{}, err
+	}
+
+	var response AddTrackResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		slog.Error("Error during AddTrackResponse", "error", err)
+		return AddTrackResponse{}, err
+	}
+
+	for start := maxTracksPerRequest; start < len(trackURI); start += maxTracksPerRequest {
+		end := min(start+maxTracksPerRequest, len(trackURI))
+		response, err = AddTrackToPlaylist(playlistID, trackURI[start:end], accessToken)
+		if err != nil {
+			return AddTrackResponse{}, err
+		}
+	}
+
+	return response, nil
+}
+
+// RemovePlaylistTracks - removes every occurrence of the trackURI from the playlist
+// spotify removes at most 100 tracks at once so the tracks are removed in chunks
+func RemovePlaylistTracks(playlistID string, trackURI []string, accessToken string) (AddTrackResponse, error) {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s/tracks", playlistID)
+
+	slog.Info("Removing tracks from the playlist", "playlistID", playlistID, "tracks", len(trackURI))
+
+	var response AddTrackResponse
+	for start := 0; start < len(trackURI); start += maxTracksPerRequest {
+		end := min(start+maxTracksPerRequest, len(trackURI))
+
+		removeTrackRequest := RemoveTrackRequest{Tracks: make([]RemoveTrack, 0, end-start)}
+		for _, uri := range trackURI[start:end] {
+			removeTrackRequest.Tracks = append(removeTrackRequest.Tracks, RemoveTrack{URI: uri})
+		}
+
+		body, err := json.Marshal(removeTrackRequest)
+		if err != nil {
+			slog.Error("Error during json.Marshal")
+			return AddTrackResponse{}, err
+		}
+
+		body, err = makeSpotifyRequest("DELETE", address, body, accessToken)
+		if err != nil {
+			slog.Error("Error making the request to the spotify")
+			return AddTrackResponse{}, err
+		}
+
+		if err := json.Unmarshal(body, &response); err != nil {
+			slog.Error("Error during AddTrackResponse", "error", err)
+			return AddTrackResponse{}, err
+		}
+	}
+
+	return response, nil
+}
+
+// UpdatePlaylistDetails - changes the name, description and visibility of the playlist
+func UpdatePlaylistDetails(playlistID string, details UpdatePlaylistDetailsRequest, accessToken string) error {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s", playlistID)
+
+	body, err := json.Marshal(details)
+	if err != nil {
+		slog.Error("Error during json.Marshal")
+		return err
+	}
+
+	_, err = makeSpotifyRequest("PUT", address, body, accessToken)
+	if err != nil {
+		slog.Error("Error making the request to the spotify")
+		return err
+	}
+
+	return nil
+}
+
+// ReorderPlaylistTracks - moves the range of tracks in the playlist. returns the new snapshot_id
+func ReorderPlaylistTracks(playlistID string, reorderTracksRequest ReorderTracksRequest, accessToken string) (AddTrackResponse, error) {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s/tracks", playlistID)
+
+	slog.Info("Reordering tracks of the playlist", "playlistID", playlistID, "rangeStart", reorderTracksRequest.RangeStart, "insertBefore", reorderTracksRequest.InsertBefore, "rangeLength", reorderTracksRequest.RangeLength)
+
+	body, err := json.Marshal(reorderTracksRequest)
+	if err != nil {
+		slog.Error("Error during json.Marshal")
+		return AddTrackResponse{}, err
+	}
+
+	body, err = makeSpotifyRequest("PUT", address, body, accessToken)
+	if err != nil {
+		slog.Error("Error making the request to the spotify")
+		return AddTrackResponse{}, err
+	}
+
+	var response AddTrackResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		slog.Error("Error during AddTrackResponse", "error", err)
+		return AddTrackResponse{}, err
+	}
+
+	return response, nil
+}
+
+// UnfollowPlaylist - removes the playlist from the user's library. Unfollowing the user's own playlist is how spotify deletes it
+func UnfollowPlaylist(playlistID string, accessToken string) error {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s/followers", playlistID)
+
+	_, err := makeSpotifyRequest("DELETE", address, nil, accessToken)
+	if err != nil {
+		slog.Error("Error making the request to the spotify")
+		return err
+	}
+
+	return nil
+}
+
+// RemovePlaylistTracksAt - removes the tracks only at the given positions of the playlist of the snapshotID
+// Positions are removed from the last one so that the earlier positions don't move between the requests
+// returns the snapshot_id after the last request
+func RemovePlaylistTracksAt(playlistID string, tracks []RemoveTrack, snapshotID string, accessToken string) (AddTrackResponse, error) {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s/tracks", playlistID)
+
+	type positionedTrack struct {
+		uri      string
+		position int
+	}
+	var positioned []positionedTrack
+	for _, track := range tracks {
+		for _, position := range track.Positions {
+			positioned = append(positioned, positionedTrack{uri: track.URI, position: position})
+		}
+	}
+	sort.Slice(positioned, func(i, j int) bool {
+		return positioned[i].position > positioned[j].position
+	})
+
+	slog.Info("Removing tracks at the positions from the playlist", "playlistID", playlistID, "tracks", len(positioned))
+
+	response := AddTrackResponse{SnapshotID: snapshotID}
+	for start := 0; start < len(positioned); start += maxTracksPerRequest {
+		end := min(start+maxTracksPerRequest, len(positioned))
+
+		removeTrackRequest := RemoveTrackRequest{SnapshotID: response.SnapshotID}
+		for _, track := range positioned[start:end] {
+			removeTrackRequest.Tracks = append(removeTrackRequest.Tracks, RemoveTrack{URI: track.uri, Positions: []int{track.position}})
+		}
+
+		body, err := json.Marshal(removeTrackRequest)
+		if err != nil {
+			slog.Error("Error during json.Marshal")
+			return AddTrackResponse{}, err
+		}
+
+		body, err = makeSpotifyRequest("DELETE", address, body, accessToken)
+		if err != nil {
+			slog.Error("Error making the request to the spotify")
+			return AddTrackResponse{}, err
+		}
+
+		if err := json.Unmarshal(body, &response); err != nil {
+			slog.Error("Error during AddTrackResponse", "error", err)
+			return AddTrackResponse{}, err
+		}
+	}
+
+	return response, nil
+}
+
+// GetPlaylistTrackURIs - returns the URIs of every track in the playlist in order (follows all the pages)
+func GetPlaylistTrackURIs(playlistID string, accessToken string) ([]string, error) {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s/tracks?fields=next,items(track(uri))&limit=100", playlistID)
+
+	var uris []string
+	for address != "" {
+		body, err := makeSpotifyGetRequest(address, accessToken)
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			Next  string `json:"next"`
+			Items []struct {
+				Track struct {
+					URI string `json:"uri"`
+				} `json:"track"`
+			} `json:"items"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, err
+		}
+
+		for _, item := range page.Items {
+			uris = append(uris, item.Track.URI)
+		}
+		address = page.Next
+	}
+
+	return uris, nil
+}
+
+// PlaylistSnapshot - the snapshot_id of the playlist with the URIs of its tracks in order.
+// URIs has the same length as the playlist so that the index is the position of the track
+type PlaylistSnapshot struct {
+	SnapshotID string
+	URIs       []string
+}
+
+// GetPlaylistSnapshot - returns the current snapshot_id and every track URI of the playlist (follows all the pages)
+// Tracks which are no longer available have an empty uri
+func GetPlaylistSnapshot(playlistID string, accessToken string) (PlaylistSnapshot, error) {
+	address := fmt.Sprintf("https://api.spotify.com/v1/playlists/%s?fields=snapshot_id,tracks(next,items(track(uri)))", playlistID)
+
+	type tracksPage struct {
+		Next  string `json:"next"`
+		Items []struct {
+			Track *struct {
+				URI string `json:"uri"`
+			} `json:"track"`
+		} `json:"items"`
+	}
+
+	body, err := makeSpotifyGetRequest(address, accessToken)
+	if err != nil {
+		return PlaylistSnapshot{}, err
+	}
+
+	var playlist struct {
+		SnapshotID string     `json:"snapshot_id"`
+		Tracks     tracksPage `json:"tracks"`
+	}
+	if err := json.Unmarshal(body, &playlist); err != nil {
+		return PlaylistSnapshot{}, err
+	}
+
+	snapshot := PlaylistSnapshot{SnapshotID: playlist.SnapshotID}
+	page := playlist.Tracks
+	for {
+		for _, item := range page.Items {
+			uri := ""
+			if item.Track != nil {
+				uri = item.Track.URI
+			}
+			snapshot.URIs = append(snapshot.URIs, uri)
+		}
+		if page.Next == "" {
+			break
+		}
+
+		body, err := makeSpotifyGetRequest(page.Next, accessToken)
+		if err != nil {
+			return PlaylistSnapshot{}, err
+		}
+		page = tracksPage{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return PlaylistSnapshot{}, err
+		}
+	}
+
+	return snapshot, nil
+}
+
 // makeSpotifyGetRequest - With the given address, make a GET request to spotify
 // returns response
 func makeSpotifyGetRequest(address string, accessToken string) ([]byte, error) {
@@ -473,8 +1144,7 @@ func makeSpotifyGetRequest(address string, accessToken string) ([]byte, error) {
 	}
 
 	// make a GET request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		slog.Error("Error response.", "err", err, "address", address)
 		return nil, err
@@ -482,8 +1152,8 @@ func makeSpotifyGetRequest(address string, accessToken string) ([]byte, error) {
 
 	// Check HTTP status code
 	if resp.StatusCode != http.StatusOK {
-		slog.Error("Error: Unexpected status code:", resp.Status)
-		return nil, err
+		slog.Error("Error: Unexpected", "status code", resp.Status, "address", address)
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 
 	defer func(Body io.ReadCloser) {
@@ -507,7 +1177,12 @@ func makeSpotifyGetRequest(address string, accessToken string) ([]byte, error) {
 
 // makeSpotifyPostRequest - make a post request where data is the body
 func makeSpotifyPostRequest(address string, data []byte, accessToken string) ([]byte, error) {
-	req, err := http.NewRequest("POST", address, bytes.NewBuffer(data))
+	return makeSpotifyRequest("POST", address, data, accessToken)
+}
+
+// makeSpotifyRequest - make a request with the method (POST, PUT, DELETE) where data is the body
+func makeSpotifyRequest(method string, address string, data []byte, accessToken string) ([]byte, error) {
+	req, err := http.NewRequest(method, address, bytes.NewBuffer(data))
 	if err != nil {
 		slog.Error("Error creating the request. Error: ", err)
 		return nil, err
@@ -517,8 +1192,7 @@ func makeSpotifyPostRequest(address string, data []byte, accessToken string) ([]
 	req.Header.Set("Authorization", "Bearer "+accessToken)
 
 	// Send request
-	client := http.Client{}
-	resp, err := client.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
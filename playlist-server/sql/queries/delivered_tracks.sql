@@ -0,0 +1,8 @@
+-- name: CreateDeliveredTracks :exec
+INSERT INTO delivered_tracks (user_id, uri, playlist_id)
+SELECT @user_id::TEXT, unnest(@uris::TEXT[]), @playlist_id::TEXT
+ON CONFLICT (user_id, uri) DO UPDATE SET playlist_id = EXCLUDED.playlist_id, delivered_at = CURRENT_TIMESTAMP;
+
+-- name: ListDeliveredTrackURIs :many
+SELECT uri FROM delivered_tracks
+WHERE user_id = @user_id AND delivered_at >= @delivered_since;
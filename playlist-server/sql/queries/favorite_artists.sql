@@ -0,0 +1,12 @@
+-- name: CreateFavoriteArtist :one
+INSERT INTO favorite_artists (user_id, spotify_artist_id, name, source)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, spotify_artist_id) DO UPDATE SET name = EXCLUDED.name
+	RETURNING *;
+
+-- name: ListFavoriteArtists :many
+SELECT * FROM favorite_artists WHERE user_id = $1 ORDER BY name;
+
+-- name: DeleteFavoriteArtist :one
+DELETE FROM favorite_artists WHERE user_id = $1 AND spotify_artist_id = $2
+RETURNING *;
@@ -0,0 +1,15 @@
+-- name: CreatePlaylistBuild :exec
+INSERT INTO playlist_builds (playlist_id, user_id, date, start_position, ranks)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (playlist_id, date) DO NOTHING;
+
+-- name: GetPlaylistBuildsByDate :many
+SELECT * FROM playlist_builds WHERE date = $1;
+
+-- name: AddPlaylistBuildRank :exec
+UPDATE playlist_builds SET ranks = array_append(ranks, $3::INTEGER)
+WHERE playlist_id = $1 AND date = $2;
+
+-- name: ShiftPlaylistBuilds :exec
+UPDATE playlist_builds SET start_position = start_position + 1
+WHERE playlist_id = $1 AND date <> $2 AND start_position >= $3;
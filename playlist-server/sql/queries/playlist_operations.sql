@@ -0,0 +1,20 @@
+-- name: CreatePlaylistOperation :one
+INSERT INTO playlist_operations (user_id, playlist_id, operation, uris, positions, spotify_snapshot_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING *;
+
+-- name: GetPlaylistOperation :one
+SELECT * FROM playlist_operations WHERE id = $1 AND user_id = $2;
+
+-- name: GetLastPlaylistOperation :one
+SELECT * FROM playlist_operations
+WHERE user_id = $1 AND undone_at IS NULL
+ORDER BY created_at DESC, id DESC
+LIMIT 1;
+
+-- name: UpdatePlaylistOperationUndone :exec
+UPDATE playlist_operations SET undone_at = $2 WHERE id = $1;
+
+-- name: ListPlaylistOperationURIs :many
+SELECT DISTINCT unnest(uris)::TEXT AS uri FROM playlist_operations
+WHERE user_id = $1 AND playlist_id = $2 AND operation = $3 AND undone_at IS NULL;
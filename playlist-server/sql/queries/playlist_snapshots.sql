@@ -0,0 +1,21 @@
+-- name: CreatePlaylistSnapshot :one
+INSERT INTO playlist_snapshots (user_id, playlist_id, spotify_snapshot_id, uris, operation)
+VALUES ($1, $2, $3, $4, $5)
+	RETURNING *;
+
+-- name: GetPlaylistSnapshot :one
+SELECT * FROM playlist_snapshots WHERE id = $1 AND user_id = $2;
+
+-- name: ListPlaylistSnapshots :many
+SELECT * FROM playlist_snapshots
+WHERE user_id = $1 AND playlist_id = $2
+ORDER BY created_at DESC, id DESC;
+
+-- name: DeleteOldPlaylistSnapshots :execrows
+DELETE FROM playlist_snapshots
+WHERE playlist_id = @playlist_id AND id NOT IN (
+    SELECT id FROM playlist_snapshots
+    WHERE playlist_id = @playlist_id
+    ORDER BY created_at DESC, id DESC
+    LIMIT @keep
+);
@@ -0,0 +1,22 @@
+-- name: CreatePlaylistSubscription :one
+INSERT INTO playlist_subscriptions (user_id, playlist_id, chart, policy, window_days)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (playlist_id) DO UPDATE SET chart = EXCLUDED.chart, policy = EXCLUDED.policy, window_days = EXCLUDED.window_days
+WHERE playlist_subscriptions.user_id = EXCLUDED.user_id
+	RETURNING *;
+
+-- name: ListPlaylistSubscriptions :many
+SELECT * FROM playlist_subscriptions WHERE user_id = $1 ORDER BY id;
+
+-- name: ListPlaylistSubscriptionsByChart :many
+SELECT * FROM playlist_subscriptions WHERE chart = $1 ORDER BY id;
+
+-- name: DeletePlaylistSubscription :one
+DELETE FROM playlist_subscriptions WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: DeletePlaylistSubscriptionByPlaylist :execrows
+DELETE FROM playlist_subscriptions WHERE user_id = $1 AND playlist_id = $2;
+
+-- name: UpdatePlaylistSubscriptionApplied :exec
+UPDATE playlist_subscriptions SET last_applied_date = $2 WHERE id = $1;
@@ -0,0 +1,18 @@
+-- name: UpsertReleaseRadar :one
+INSERT INTO release_radars (user_id, playlist_id, include_followed)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE SET playlist_id = EXCLUDED.playlist_id, include_followed = EXCLUDED.include_followed
+	RETURNING *;
+
+-- name: GetReleaseRadar :one
+SELECT * FROM release_radars WHERE user_id = $1;
+
+-- name: DeleteReleaseRadar :one
+DELETE FROM release_radars WHERE user_id = $1
+RETURNING *;
+
+-- name: ListDueReleaseRadars :many
+SELECT * FROM release_radars WHERE last_run_at IS NULL OR last_run_at < $1;
+
+-- name: UpdateReleaseRadarRun :exec
+UPDATE release_radars SET last_run_at = $2 WHERE user_id = $1;
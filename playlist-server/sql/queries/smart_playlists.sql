@@ -0,0 +1,37 @@
+-- name: UpsertSmartPlaylist :one
+INSERT INTO smart_playlists (user_id, name, playlist_id, rules)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, name) DO UPDATE SET playlist_id = EXCLUDED.playlist_id, rules = EXCLUDED.rules, updated_at = CURRENT_TIMESTAMP
+	RETURNING *;
+
+-- name: GetSmartPlaylist :one
+SELECT * FROM smart_playlists WHERE id = $1 AND user_id = $2;
+
+-- name: ListSmartPlaylists :many
+SELECT * FROM smart_playlists WHERE user_id = $1 ORDER BY name;
+
+-- name: DeleteSmartPlaylist :one
+DELETE FROM smart_playlists WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: UpdateSmartPlaylistEvaluated :exec
+UPDATE smart_playlists SET playlist_id = $2, last_evaluated_at = $3 WHERE id = $1;
+
+-- name: GetLatestChartDate :one
+SELECT MAX(date)::DATE FROM tracks;
+
+-- name: GetSmartPlaylistCandidates :many
+WITH history AS (
+    SELECT uri, COUNT(DISTINCT date)::INTEGER AS days_on_chart, MIN(date)::DATE AS first_chart_date
+    FROM tracks
+    WHERE date <= @date AND review_status = 'approved'
+    GROUP BY uri
+)
+SELECT tracks.rank, tracks.title, tracks.artist, tracks.uri, tracks.melon_artist, history.days_on_chart, history.first_chart_date
+FROM tracks
+JOIN history ON history.uri = tracks.uri
+WHERE tracks.date = @date AND tracks.review_status = 'approved'
+    AND tracks.rank BETWEEN @from_rank AND @to_rank
+    AND history.days_on_chart >= @min_days_on_chart
+    AND (NOT @new_entries_only::BOOLEAN OR history.first_chart_date = @date)
+ORDER BY tracks.rank;
@@ -1,27 +1,49 @@
 -- name: CreateTrack :one
-INSERT INTO tracks (rank, title, artist, uri, date)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO tracks (rank, title, artist, uri, date, melon_title, melon_artist, melon_album, album, duration_ms, isrc, match_method, match_confidence, review_status)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	RETURNING *;
 
+-- name: BackfillTrack :execrows
+INSERT INTO tracks (rank, title, artist, uri, date, melon_title, melon_artist, melon_album, album, duration_ms, isrc, match_method, match_confidence)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+ON CONFLICT (uri, date) DO NOTHING;
+
 -- name: GetTracksByDate :many
-SELECT * FROM tracks WHERE date = $1;
+SELECT * FROM tracks WHERE date = $1 ORDER BY rank;
+
 
+-- name: UpsertMissedTrack :one
+INSERT INTO missed_tracks (title, artist, album)
+VALUES ($1, $2, $3)
+ON CONFLICT (title, artist) DO UPDATE SET album = EXCLUDED.album
+	RETURNING *;
 
--- name: CreateMissedTrack :one
-INSERT INTO missed_tracks (rank, title, artist, date)
-VALUES ($1, $2, $3, $4)
+-- name: CreateMissedTrackAppearance :one
+INSERT INTO missed_track_appearances (missed_track_id, rank, date)
+VALUES ($1, $2, $3)
+ON CONFLICT (missed_track_id, date) DO UPDATE SET rank = EXCLUDED.rank
 	RETURNING *;
 
 -- name: GetMissedTracks :one
 SELECT * FROM missed_tracks WHERE title = $1 AND artist = $2;
 
 -- name: GetMissedTracksByDate :many
-SELECT * FROM missed_tracks WHERE date = $1;
+SELECT missed_tracks.id, missed_tracks.title, missed_tracks.artist, missed_tracks.album,
+	missed_track_appearances.rank, missed_track_appearances.date,
+	(SELECT COUNT(*) FROM missed_track_appearances a WHERE a.missed_track_id = missed_tracks.id)::INTEGER AS days_missed,
+	(SELECT MIN(a.date) FROM missed_track_appearances a WHERE a.missed_track_id = missed_tracks.id)::DATE AS first_missed_date
+FROM missed_tracks
+JOIN missed_track_appearances ON missed_track_appearances.missed_track_id = missed_tracks.id
+WHERE missed_track_appearances.date = $1
+ORDER BY missed_track_appearances.rank;
+
+-- name: GetMissedTrackAppearances :many
+SELECT * FROM missed_track_appearances WHERE missed_track_id = $1 ORDER BY date;
 
 
 -- name: CreateResolvedTrack :one
-INSERT INTO resolved_tracks (missed_title, missed_artist, title, artist, uri, date)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO resolved_tracks (missed_title, missed_artist, title, artist, uri, date, album, duration_ms, isrc)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	RETURNING *;
 
 -- name: GetResolvedTrack :one
@@ -29,4 +51,99 @@ SELECT * FROM resolved_tracks WHERE missed_title = $1 AND missed_artist = $2;
 
 -- name: RemoveMissedTrack :one
 DELETE FROM missed_tracks WHERE title = $1 AND artist = $2
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: ListResolvedTracks :many
+SELECT * FROM resolved_tracks
+WHERE NOT sqlc.arg(reported_only)::BOOLEAN OR reported_wrong
+ORDER BY date DESC;
+
+-- name: UpdateResolvedTrack :one
+UPDATE resolved_tracks
+SET title = $3,
+    artist = $4,
+    uri = $5,
+    album = $6,
+    duration_ms = $7,
+    isrc = $8,
+    date = $9,
+    reported_wrong = false
+WHERE missed_title = $1 AND missed_artist = $2
+RETURNING *;
+
+-- name: DeleteResolvedTrack :one
+DELETE FROM resolved_tracks WHERE missed_title = $1 AND missed_artist = $2
+RETURNING *;
+
+-- name: UpdateTracksForResolvedTrack :execrows
+UPDATE tracks
+SET title = @title,
+    artist = @artist,
+    uri = @new_uri,
+    album = @album,
+    duration_ms = @duration_ms,
+    isrc = @isrc,
+    match_method = @match_method,
+    match_confidence = @match_confidence,
+    reported_wrong = false,
+    review_status = 'approved'
+WHERE melon_title = @melon_title AND melon_artist = @melon_artist AND uri = @old_uri
+  AND NOT EXISTS (
+    SELECT 1 FROM tracks existing
+    WHERE existing.uri = @new_uri AND existing.date = tracks.date AND existing.uri <> tracks.uri
+  );
+
+-- name: ListResolvedTrackConflictDates :many
+SELECT date FROM tracks
+WHERE melon_title = @melon_title AND melon_artist = @melon_artist AND uri = @old_uri
+  AND EXISTS (
+    SELECT 1 FROM tracks existing
+    WHERE existing.uri = @new_uri AND existing.date = tracks.date AND existing.uri <> tracks.uri
+  )
+ORDER BY date;
+
+-- name: DeleteTracksForResolvedTrack :many
+DELETE FROM tracks WHERE melon_title = $1 AND melon_artist = $2 AND uri = $3
+RETURNING *;
+
+-- name: ReportTrackWrongMatch :execrows
+UPDATE tracks SET reported_wrong = true WHERE uri = $1;
+
+-- name: ReportResolvedTrackWrongMatch :execrows
+UPDATE resolved_tracks SET reported_wrong = true WHERE uri = $1;
+
+
+-- name: ListPendingTracks :many
+SELECT * FROM tracks
+WHERE review_status = 'pending' AND (sqlc.narg(date)::DATE IS NULL OR date = sqlc.narg(date))
+ORDER BY date DESC, rank;
+
+-- name: ApprovePendingTracks :many
+UPDATE tracks SET review_status = 'approved'
+WHERE melon_title = $1 AND melon_artist = $2 AND uri = $3 AND review_status = 'pending'
+RETURNING *;
+
+-- name: DeletePendingTracks :many
+DELETE FROM tracks WHERE melon_title = $1 AND melon_artist = $2 AND uri = $3 AND review_status = 'pending'
+RETURNING *;
+
+-- name: ReplacePendingTracks :execrows
+UPDATE tracks
+SET title = @title,
+    artist = @artist,
+    uri = @new_uri,
+    album = @album,
+    duration_ms = @duration_ms,
+    isrc = @isrc,
+    match_method = @match_method,
+    match_confidence = @match_confidence,
+    reported_wrong = false,
+    review_status = 'approved'
+WHERE melon_title = @melon_title AND melon_artist = @melon_artist AND uri = @old_uri AND review_status = 'pending';
+
+-- name: GetTrackURIsBetweenDates :many
+SELECT uri, MIN(rank)::INTEGER AS best_rank, MAX(date)::DATE AS last_date
+FROM tracks
+WHERE date BETWEEN @from_date AND @to_date AND review_status = 'approved'
+GROUP BY uri
+ORDER BY last_date DESC, best_rank;
@@ -0,0 +1,37 @@
+-- +goose Up
+ALTER TABLE tracks
+    ADD COLUMN melon_title TEXT NOT NULL DEFAULT '',
+    ADD COLUMN melon_artist TEXT NOT NULL DEFAULT '',
+    ADD COLUMN melon_album TEXT NOT NULL DEFAULT '',
+    ADD COLUMN album TEXT NOT NULL DEFAULT '',
+    ADD COLUMN duration_ms INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN isrc TEXT NOT NULL DEFAULT '',
+    ADD COLUMN match_method TEXT NOT NULL DEFAULT '',
+    ADD COLUMN match_confidence REAL NOT NULL DEFAULT 0;
+
+ALTER TABLE missed_tracks
+    ADD COLUMN album TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE resolved_tracks
+    ADD COLUMN album TEXT NOT NULL DEFAULT '',
+    ADD COLUMN duration_ms INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN isrc TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE resolved_tracks
+    DROP COLUMN album,
+    DROP COLUMN duration_ms,
+    DROP COLUMN isrc;
+
+ALTER TABLE missed_tracks
+    DROP COLUMN album;
+
+ALTER TABLE tracks
+    DROP COLUMN melon_title,
+    DROP COLUMN melon_artist,
+    DROP COLUMN melon_album,
+    DROP COLUMN album,
+    DROP COLUMN duration_ms,
+    DROP COLUMN isrc,
+    DROP COLUMN match_method,
+    DROP COLUMN match_confidence;
@@ -0,0 +1,51 @@
+-- +goose Up
+-- A missed track is a unique title/artist and each day it's missed from the chart is saved as an appearance
+ALTER TABLE missed_tracks RENAME TO missed_tracks_old;
+
+CREATE TABLE missed_tracks (
+    id SERIAL PRIMARY KEY,
+    title TEXT NOT NULL,
+    artist TEXT NOT NULL,
+    album TEXT NOT NULL DEFAULT '',
+    UNIQUE (title, artist)
+);
+
+CREATE TABLE missed_track_appearances (
+    missed_track_id INTEGER NOT NULL REFERENCES missed_tracks(id) ON DELETE CASCADE,
+    rank INTEGER NOT NULL,
+    date DATE NOT NULL,
+    PRIMARY KEY (missed_track_id, date)
+);
+CREATE INDEX idx_missed_track_appearances_date ON missed_track_appearances(date);
+
+INSERT INTO missed_tracks (title, artist, album)
+SELECT title, artist, album FROM missed_tracks_old;
+
+INSERT INTO missed_track_appearances (missed_track_id, rank, date)
+SELECT missed_tracks.id, missed_tracks_old.rank, missed_tracks_old.date
+FROM missed_tracks_old
+JOIN missed_tracks ON missed_tracks.title = missed_tracks_old.title AND missed_tracks.artist = missed_tracks_old.artist;
+
+DROP TABLE missed_tracks_old;
+
+-- +goose Down
+ALTER TABLE missed_tracks RENAME TO missed_tracks_new;
+
+CREATE TABLE missed_tracks (
+    rank INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    artist TEXT NOT NULL,
+    date DATE NOT NULL,
+    album TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (title, artist)
+);
+
+-- Only the first appearance can be kept
+INSERT INTO missed_tracks (rank, title, artist, date, album)
+SELECT DISTINCT ON (missed_tracks_new.id) missed_track_appearances.rank, missed_tracks_new.title, missed_tracks_new.artist, missed_track_appearances.date, missed_tracks_new.album
+FROM missed_tracks_new
+JOIN missed_track_appearances ON missed_track_appearances.missed_track_id = missed_tracks_new.id
+ORDER BY missed_tracks_new.id, missed_track_appearances.date;
+
+DROP TABLE missed_track_appearances;
+DROP TABLE missed_tracks_new;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Spotify playlists created from the melon chart of the date, so that tracks resolved later can be added to them
+CREATE TABLE playlist_builds (
+    playlist_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    date DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (playlist_id, date)
+);
+CREATE INDEX idx_playlist_builds_date ON playlist_builds(date);
+
+-- +goose Down
+DROP TABLE playlist_builds;
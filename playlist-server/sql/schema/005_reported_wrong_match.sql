@@ -0,0 +1,14 @@
+-- +goose Up
+-- Set by the users when the spotify track in the playlist isn't the melon chart song
+ALTER TABLE tracks
+    ADD COLUMN reported_wrong BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE resolved_tracks
+    ADD COLUMN reported_wrong BOOLEAN NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE resolved_tracks
+    DROP COLUMN reported_wrong;
+
+ALTER TABLE tracks
+    DROP COLUMN reported_wrong;
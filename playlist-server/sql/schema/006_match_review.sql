@@ -0,0 +1,12 @@
+-- +goose Up
+-- Tracks matched with a low confidence are saved as 'pending' and left out of the playlists until approved
+ALTER TABLE tracks
+    ADD COLUMN review_status TEXT NOT NULL DEFAULT 'approved';
+
+CREATE INDEX tracks_pending_review_idx ON tracks (date) WHERE review_status = 'pending';
+
+-- +goose Down
+DROP INDEX tracks_pending_review_idx;
+
+ALTER TABLE tracks
+    DROP COLUMN review_status;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Spotify playlists updated automatically after each daily chart ingestion
+-- policy is one of append_new, mirror and rolling_window (window_days is only used by rolling_window)
+CREATE TABLE playlist_subscriptions (
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    playlist_id TEXT NOT NULL UNIQUE,
+    chart TEXT NOT NULL,
+    policy TEXT NOT NULL,
+    window_days INTEGER NOT NULL DEFAULT 7,
+    last_applied_date DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_playlist_subscriptions_user_id ON playlist_subscriptions(user_id);
+
+-- +goose Down
+DROP TABLE playlist_subscriptions;
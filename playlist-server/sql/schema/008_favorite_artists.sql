@@ -0,0 +1,13 @@
+-- +goose Up
+-- Spotify artists picked by the user. source is how it was added (manual, followed or top)
+CREATE TABLE favorite_artists (
+    user_id TEXT NOT NULL,
+    spotify_artist_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    source TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, spotify_artist_id)
+);
+
+-- +goose Down
+DROP TABLE favorite_artists;
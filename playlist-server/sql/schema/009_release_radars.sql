@@ -0,0 +1,21 @@
+-- +goose Up
+-- Weekly playlist of the new releases by the user's favorite (and optionally followed) artists
+CREATE TABLE release_radars (
+    user_id TEXT PRIMARY KEY,
+    playlist_id TEXT NOT NULL,
+    include_followed BOOLEAN NOT NULL DEFAULT false,
+    last_run_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Tracks already written to the user's release radar so they aren't delivered again the next week
+CREATE TABLE release_radar_deliveries (
+    user_id TEXT NOT NULL REFERENCES release_radars(user_id) ON DELETE CASCADE,
+    uri TEXT NOT NULL,
+    delivered_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, uri)
+);
+
+-- +goose Down
+DROP TABLE release_radar_deliveries;
+DROP TABLE release_radars;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Last time each track was added to one of the user's playlists by melongo. Used to skip the tracks already delivered
+CREATE TABLE delivered_tracks (
+    user_id TEXT NOT NULL,
+    uri TEXT NOT NULL,
+    playlist_id TEXT NOT NULL,
+    delivered_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, uri)
+);
+
+CREATE INDEX idx_delivered_tracks_delivered_at ON delivered_tracks(user_id, delivered_at);
+
+-- +goose Down
+DROP TABLE delivered_tracks;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- User defined playlist rules (JSON) evaluated against the saved charts
+CREATE TABLE smart_playlists (
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    playlist_id TEXT NOT NULL DEFAULT '',
+    rules JSONB NOT NULL,
+    last_evaluated_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name)
+);
+
+-- +goose Down
+DROP TABLE smart_playlists;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Contents of the playlist right before melongo wrote to it so that the playlist can be restored
+CREATE TABLE playlist_snapshots (
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    playlist_id TEXT NOT NULL,
+    spotify_snapshot_id TEXT NOT NULL,
+    uris TEXT[] NOT NULL,
+    operation TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_playlist_snapshots_playlist_id ON playlist_snapshots(playlist_id, created_at);
+
+-- +goose Down
+DROP TABLE playlist_snapshots;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Tracks added to the playlist by melongo with their positions so that exactly those items can be removed again
+CREATE TABLE playlist_operations (
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    playlist_id TEXT NOT NULL,
+    operation TEXT NOT NULL,
+    uris TEXT[] NOT NULL,
+    positions INTEGER[] NOT NULL,
+    -- snapshot_id of the playlist right after the tracks were added
+    spotify_snapshot_id TEXT NOT NULL,
+    undone_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_playlist_operations_user_id ON playlist_operations(user_id, created_at);
+
+-- +goose Down
+DROP TABLE playlist_operations;
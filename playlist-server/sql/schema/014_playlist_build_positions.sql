@@ -0,0 +1,10 @@
+-- +goose Up
+-- Where the chart tracks start in the playlist and the chart rank of each of them in the playlist order,
+-- so that a resolved track can be inserted right after the tracks ranked above it.
+-- Builds saved before have neither and are skipped
+ALTER TABLE playlist_builds ADD COLUMN start_position INTEGER;
+ALTER TABLE playlist_builds ADD COLUMN ranks INTEGER[];
+
+-- +goose Down
+ALTER TABLE playlist_builds DROP COLUMN ranks;
+ALTER TABLE playlist_builds DROP COLUMN start_position;
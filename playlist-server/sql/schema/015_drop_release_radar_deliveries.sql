@@ -0,0 +1,17 @@
+-- +goose Up
+-- The release radar skips the tracks in delivered_tracks like the other playlists, so its own deliveries are moved there
+INSERT INTO delivered_tracks (user_id, uri, playlist_id, delivered_at)
+SELECT deliveries.user_id, deliveries.uri, release_radars.playlist_id, deliveries.delivered_at
+FROM release_radar_deliveries deliveries
+JOIN release_radars ON release_radars.user_id = deliveries.user_id
+ON CONFLICT (user_id, uri) DO NOTHING;
+
+DROP TABLE release_radar_deliveries;
+
+-- +goose Down
+CREATE TABLE release_radar_deliveries (
+    user_id TEXT NOT NULL REFERENCES release_radars(user_id) ON DELETE CASCADE,
+    uri TEXT NOT NULL,
+    delivered_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, uri)
+);
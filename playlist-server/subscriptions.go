@@ -0,0 +1,301 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+)
+
+// Charts that can be subscribed to. Only the melon top 100 is ingested daily for now
+const chartMelonTop100 = "melon_top100"
+
+// How the subscribed playlist is updated after each ingestion
+const (
+	subscriptionPolicyAppendNew     = "append_new"     // add the chart tracks which aren't in the playlist yet
+	subscriptionPolicyMirror        = "mirror"         // replace the playlist with the chart of the day
+	subscriptionPolicyRollingWindow = "rolling_window" // keep every chart track of the last windowDays days and remove the older ones
+)
+
+const defaultSubscriptionWindowDays = 7
+
+// CreatePlaylistSubscription subscribes the user's playlist to the chart. Subscribing the same playlist again changes its policy
+func (playlistServer *PlaylistServer) CreatePlaylistSubscription(ctx context.Context, req *proto.CreatePlaylistSubscriptionRequest) (*proto.CreatePlaylistSubscriptionResponse, error) {
+	if req.PlaylistID == "" || req.UserID == "" {
+		return nil, fmt.Errorf("playlistID and userID are required")
+	}
+
+	chart := req.Chart
+	if chart == "" {
+		chart = chartMelonTop100
+	}
+	if chart != chartMelonTop100 {
+		return nil, fmt.Errorf("unsupported chart: %s", req.Chart)
+	}
+
+	switch req.Policy {
+	case subscriptionPolicyAppendNew, subscriptionPolicyMirror, subscriptionPolicyRollingWindow:
+	default:
+		return nil, fmt.Errorf("unsupported subscription policy: %s", req.Policy)
+	}
+
+	windowDays := req.WindowDays
+	if windowDays <= 0 {
+		windowDays = defaultSubscriptionWindowDays
+	}
+
+	subscription, err := playlistServer.DB.CreatePlaylistSubscription(ctx, database.CreatePlaylistSubscriptionParams{
+		UserID:     req.UserID,
+		PlaylistID: req.PlaylistID,
+		Chart:      chart,
+		Policy:     req.Policy,
+		WindowDays: windowDays,
+	})
+	if err == sql.ErrNoRows {
+		// Conflicting playlist is subscribed by a different user
+		return nil, fmt.Errorf("playlist is already subscribed: %s", req.PlaylistID)
+	} else if err != nil {
+		slog.Error("Error creating playlist subscription", "playlistID", req.PlaylistID, "error", err)
+		return nil, fmt.Errorf("error creating playlist subscription: %v", err)
+	}
+
+	slog.Info("Created playlist subscription", "userID", req.UserID, "playlistID", req.PlaylistID, "chart", chart, "policy", req.Policy)
+
+	return &proto.CreatePlaylistSubscriptionResponse{
+		Subscription: convertSubscriptionToProto(subscription),
+	}, nil
+}
+
+func (playlistServer *PlaylistServer) ListPlaylistSubscriptions(ctx context.Context, req *proto.ListPlaylistSubscriptionsRequest) (*proto.ListPlaylistSubscriptionsResponse, error) {
+	subscriptions, err := playlistServer.DB.ListPlaylistSubscriptions(ctx, req.UserID)
+	if err != nil {
+		slog.Error("Error listing playlist subscriptions", "userID", req.UserID, "error", err)
+		return nil, fmt.Errorf("error listing playlist subscriptions: %v", err)
+	}
+
+	protoSubscriptions := make([]*proto.PlaylistSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		protoSubscriptions = append(protoSubscriptions, convertSubscriptionToProto(subscription))
+	}
+
+	return &proto.ListPlaylistSubscriptionsResponse{
+		Subscriptions: protoSubscriptions,
+	}, nil
+}
+
+// DeletePlaylistSubscription stops updating the playlist. The tracks already in the playlist are kept
+func (playlistServer *PlaylistServer) DeletePlaylistSubscription(ctx context.Context, req *proto.DeletePlaylistSubscriptionRequest) (*proto.DeletePlaylistSubscriptionResponse, error) {
+	subscription, err := playlistServer.DB.DeletePlaylistSubscription(ctx, database.DeletePlaylistSubscriptionParams{
+		ID:     req.Id,
+		UserID: req.UserID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("playlist subscription not found: %d", req.Id)
+	} else if err != nil {
+		slog.Error("Error deleting playlist subscription", "id", req.Id, "error", err)
+		return nil, err
+	}
+
+	return &proto.DeletePlaylistSubscriptionResponse{
+		Subscription: convertSubscriptionToProto(subscription),
+	}, nil
+}
+
+// applySubscriptions updates every playlist subscribed to the chart with the tracks saved for the date.
+// Called after the daily ingestion with the token of each subscribed user from the auth server
+func (playlistServer *PlaylistServer) applySubscriptions(chart string, date time.Time) {
+	ctx := context.Background()
+	// The chart date comes with the time of the ingestion. Only the day is compared with the chart dates
+	// so that the window and the last applied date don't depend on when the ingestion ran
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	subscriptions, err := playlistServer.DB.ListPlaylistSubscriptionsByChart(ctx, chart)
+	if err != nil {
+		slog.Error("Error listing playlist subscriptions", "chart", chart, "error", err)
+		return
+	}
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	songs, err := playlistServer.DB.GetTracksByDate(ctx, date)
+	if err != nil {
+		slog.Error("Error getting tracks by date", "date", date, "error", err)
+		return
+	}
+
+	var chartURIs []string
+	for _, song := range songs {
+		// Low confidence matches are left out until approved
+		if song.ReviewStatus == reviewStatusPending {
+			continue
+		}
+		chartURIs = append(chartURIs, song.Uri)
+	}
+
+	applied := 0
+	for _, subscription := range subscriptions {
+		if err := playlistServer.applySubscription(ctx, subscription, date, chartURIs); err != nil {
+			slog.Error("Error applying playlist subscription", "id", subscription.ID, "playlistID", subscription.PlaylistID, "policy", subscription.Policy, "error", err)
+			continue
+		}
+		applied++
+	}
+
+	slog.Info("Applied playlist subscriptions", "chart", chart, "date", date, "applied", applied, "subscriptions", len(subscriptions))
+}
+
+func (playlistServer *PlaylistServer) applySubscription(ctx context.Context, subscription database.PlaylistSubscription, date time.Time, chartURIs []string) error {
+	accessToken, err := getUserAccessToken(subscription.UserID)
+	if err != nil {
+		return fmt.Errorf("error getting the user token: %v", err)
+	}
+
+	switch subscription.Policy {
+	case subscriptionPolicyAppendNew:
+		existingURIs, err := spotify.GetPlaylistTrackURIs(subscription.PlaylistID, accessToken)
+		if err != nil {
+			return err
+		}
+
+		existing := make(map[string]bool, len(existingURIs))
+		for _, uri := range existingURIs {
+			existing[uri] = true
+		}
+
+		var newURIs []string
+		for _, uri := range chartURIs {
+			if !existing[uri] {
+				newURIs = append(newURIs, uri)
+			}
+		}
+
+		if _, err := playlistServer.addTracksToUserPlaylist(ctx, subscription.UserID, subscription.PlaylistID, operationSubscription+subscription.Policy, newURIs, accessToken); err != nil {
+			return err
+		}
+
+	case subscriptionPolicyMirror:
+		if _, err := playlistServer.replaceUserPlaylistTracks(ctx, subscription.UserID, subscription.PlaylistID, operationSubscription+subscription.Policy, chartURIs, accessToken); err != nil {
+			return err
+		}
+
+	case subscriptionPolicyRollingWindow:
+		if err := playlistServer.applyRollingWindow(ctx, subscription, date, accessToken); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("unsupported subscription policy: %s", subscription.Policy)
+	}
+
+	return playlistServer.DB.UpdatePlaylistSubscriptionApplied(ctx, database.UpdatePlaylistSubscriptionAppliedParams{
+		ID:              subscription.ID,
+		LastAppliedDate: sql.NullTime{Time: date, Valid: true},
+	})
+}
+
+// applyRollingWindow adds the chart tracks of the last windowDays days which aren't in the playlist yet and
+// removes the tracks added by the subscription which are no longer in the window. Tracks added by the user are kept
+func (playlistServer *PlaylistServer) applyRollingWindow(ctx context.Context, subscription database.PlaylistSubscription, date time.Time, accessToken string) error {
+	windowStart := date.AddDate(0, 0, -int(subscription.WindowDays-1))
+	operation := operationSubscription + subscription.Policy
+
+	windowTracks, err := playlistServer.DB.GetTrackURIsBetweenDates(ctx, database.GetTrackURIsBetweenDatesParams{
+		FromDate: windowStart,
+		ToDate:   date,
+	})
+	if err != nil {
+		return err
+	}
+	inWindow := make(map[string]bool, len(windowTracks))
+	for _, track := range windowTracks {
+		inWindow[track.Uri] = true
+	}
+
+	// Only the tracks the subscription added (and weren't undone) can expire
+	addedURIs, err := playlistServer.DB.ListPlaylistOperationURIs(ctx, database.ListPlaylistOperationURIsParams{
+		UserID:     subscription.UserID,
+		PlaylistID: subscription.PlaylistID,
+		Operation:  operation,
+	})
+	if err != nil {
+		return err
+	}
+
+	existingURIs, err := spotify.GetPlaylistTrackURIs(subscription.PlaylistID, accessToken)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]bool, len(existingURIs))
+	for _, uri := range existingURIs {
+		existing[uri] = true
+	}
+
+	var newURIs, expiredURIs []string
+	for _, track := range windowTracks {
+		if !existing[track.Uri] {
+			newURIs = append(newURIs, track.Uri)
+		}
+	}
+	for _, uri := range addedURIs {
+		if existing[uri] && !inWindow[uri] {
+			expiredURIs = append(expiredURIs, uri)
+		}
+	}
+
+	if len(newURIs) == 0 && len(expiredURIs) == 0 {
+		return nil
+	}
+	before, err := playlistServer.snapshotPlaylist(ctx, subscription.UserID, subscription.PlaylistID, operation, accessToken)
+	if err != nil {
+		return err
+	}
+
+	if len(expiredURIs) > 0 {
+		if _, err := spotify.RemovePlaylistTracks(subscription.PlaylistID, expiredURIs, accessToken); err != nil {
+			return err
+		}
+	}
+	if len(newURIs) > 0 {
+		snapshotID, err := addTracksToPlaylistInChunks(subscription.PlaylistID, newURIs, accessToken)
+		if err != nil {
+			return err
+		}
+		playlistServer.recordDeliveredTracks(subscription.UserID, subscription.PlaylistID, newURIs)
+
+		// The new tracks are appended after the tracks left once the expired ones are removed
+		expired := make(map[string]bool, len(expiredURIs))
+		for _, uri := range expiredURIs {
+			expired[uri] = true
+		}
+		remaining := 0
+		for _, uri := range before.Uris {
+			if !expired[uri] {
+				remaining++
+			}
+		}
+		playlistServer.recordPlaylistOperation(ctx, subscription.UserID, subscription.PlaylistID, operation, newURIs, remaining, snapshotID)
+	}
+
+	slog.Info("Applied rolling window", "playlistID", subscription.PlaylistID, "windowDays", subscription.WindowDays, "added", len(newURIs), "removed", len(expiredURIs))
+	return nil
+}
+
+func convertSubscriptionToProto(subscription database.PlaylistSubscription) *proto.PlaylistSubscription {
+	protoSubscription := &proto.PlaylistSubscription{
+		Id:         subscription.ID,
+		PlaylistID: subscription.PlaylistID,
+		Chart:      subscription.Chart,
+		Policy:     subscription.Policy,
+		WindowDays: subscription.WindowDays,
+	}
+	if subscription.LastAppliedDate.Valid {
+		protoSubscription.LastAppliedDate = subscription.LastAppliedDate.Time.Format("2006-01-02")
+	}
+	return protoSubscription
+}
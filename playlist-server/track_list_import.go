@@ -0,0 +1,310 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/akimdev15/melongo/playlist-server/internal/database"
+	"github.com/akimdev15/melongo/playlist-server/proto"
+	"github.com/akimdev15/melongo/playlist-server/spotify"
+)
+
+// Status of each line of ImportTrackList
+const (
+	trackListStatusMatched       = "matched"        // spotify search result with enough confidence
+	trackListStatusResolved      = "resolved"       // from the resolved_tracks DB
+	trackListStatusLowConfidence = "low_confidence" // search result isn't close enough and the song isn't resolved
+	trackListStatusUnmatched     = "unmatched"      // no search result and the song isn't resolved
+	trackListStatusDuplicate     = "duplicate"      // same song as one of the previous lines
+	trackListStatusInvalid       = "invalid"        // neither "artist - title" nor a CSV row with both
+)
+
+const trackListPlaylistDescription = "Imported track list by melongo"
+
+// Number of lines searched on spotify at the same time
+const trackListSearchWorkers = 8
+
+// trackListLine is a parsed line of the track list
+type trackListLine struct {
+	number int32
+	text   string
+	title  string
+	artist string
+}
+
+// trackListParser reads "artist - title" lines. When the first line is a CSV header with the title and artist
+// columns, the rest of the lines are read as CSV rows instead
+type trackListParser struct {
+	started bool
+	columns map[string]int
+}
+
+func (parser *trackListParser) parse(number int32, text string) (trackListLine, bool, error) {
+	line := trackListLine{number: number, text: strings.TrimSpace(strings.TrimPrefix(text, "\ufeff"))}
+	// Blank lines and comments (ex. #EXTM3U) are skipped
+	if line.text == "" || strings.HasPrefix(line.text, "#") {
+		return line, false, nil
+	}
+
+	if !parser.started {
+		parser.started = true
+		if columns, ok := parseTrackListHeader(line.text); ok {
+			parser.columns = columns
+			return line, false, nil
+		}
+	}
+
+	if parser.columns != nil {
+		record, err := csv.NewReader(strings.NewReader(line.text)).Read()
+		if err != nil {
+			return line, true, fmt.Errorf("invalid CSV row: %v", err)
+		}
+		if i := parser.columns["title"]; i < len(record) {
+			line.title = strings.TrimSpace(record[i])
+		}
+		if i := parser.columns["artist"]; i < len(record) {
+			line.artist = strings.TrimSpace(record[i])
+		}
+	} else {
+		line.artist, line.title = splitArtistTitle(line.text)
+	}
+
+	if line.title == "" || line.artist == "" {
+		return line, true, fmt.Errorf("expected \"artist - title\"")
+	}
+	return line, true, nil
+}
+
+// parseTrackListHeader returns the columns of the CSV header. ex) "rank,title,artist" or "곡명,아티스트"
+func parseTrackListHeader(text string) (map[string]int, bool) {
+	record, err := csv.NewReader(strings.NewReader(text)).Read()
+	if err != nil || len(record) < 2 {
+		return nil, false
+	}
+
+	columns := make(map[string]int)
+	for i, name := range record {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "title", "song", "track", "name", "곡명", "제목", "노래":
+			if _, ok := columns["title"]; !ok {
+				columns["title"] = i
+			}
+		case "artist", "singer", "아티스트", "가수":
+			if _, ok := columns["artist"]; !ok {
+				columns["artist"] = i
+			}
+		}
+	}
+
+	_, hasTitle := columns["title"]
+	_, hasArtist := columns["artist"]
+	return columns, hasTitle && hasArtist
+}
+
+// splitArtistTitle splits "artist - title" at the first separator since titles contain dashes more often than artists
+func splitArtistTitle(text string) (string, string) {
+	for _, separator := range []string{" - ", " – ", " — ", "\t"} {
+		if artist, title, ok := strings.Cut(text, separator); ok {
+			return strings.TrimSpace(artist), strings.TrimSpace(title)
+		}
+	}
+	return "", ""
+}
+
+// ImportTrackList matches every line of the streamed track list the same way as the melon chart songs
+// (spotify search, then the resolved tracks for the low confidence matches) and adds the matched tracks to the playlist.
+// With dryRun, the playlist isn't created or changed and only the report is returned
+func (playlistServer *PlaylistServer) ImportTrackList(stream proto.PlaylistService_ImportTrackListServer) error {
+	var options *proto.ImportTrackListLine
+	var lines []trackListLine
+	var results []*proto.ImportTrackListResult
+	parser := &trackListParser{}
+
+	for number := int32(1); ; number++ {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			slog.Error("Error receiving the track list line", "line", number, "error", err)
+			return err
+		}
+
+		if options == nil {
+			options = req
+		}
+
+		line, ok, err := parser.parse(number, req.Line)
+		if err != nil {
+			results = append(results, &proto.ImportTrackListResult{
+				Line:   line.number,
+				Text:   line.text,
+				Status: trackListStatusInvalid,
+				Error:  err.Error(),
+			})
+		} else if ok {
+			lines = append(lines, line)
+		}
+	}
+
+	if options == nil {
+		return fmt.Errorf("track list is empty")
+	}
+
+	response := &proto.ImportTrackListResponse{
+		DryRun:     options.DryRun,
+		PlaylistID: options.PlaylistID,
+	}
+
+	results = append(results, playlistServer.matchTrackList(stream.Context(), lines, options.AccessToken)...)
+
+	// Keep the order of the file in the playlist and the report
+	uris := make([]string, 0, len(results))
+	added := make(map[string]int32)
+	for _, result := range sortTrackListResults(results) {
+		if result.Uri != "" && (result.Status == trackListStatusMatched || result.Status == trackListStatusResolved) {
+			if line, ok := added[result.Uri]; ok {
+				result.Status = trackListStatusDuplicate
+				result.Error = fmt.Sprintf("same track as line %d", line)
+			} else {
+				added[result.Uri] = result.Line
+				uris = append(uris, result.Uri)
+				response.Matched++
+				continue
+			}
+		}
+		response.Unmatched++
+		response.UnmatchedLines = append(response.UnmatchedLines, result)
+	}
+	response.Total = response.Matched + response.Unmatched
+
+	if options.DryRun || len(uris) == 0 {
+		response.Status = fmt.Sprintf("Matched %d of %d lines", response.Matched, response.Total)
+		return stream.SendAndClose(response)
+	}
+
+	if response.PlaylistID == "" {
+		name := options.PlaylistName
+		if name == "" {
+			name = fmt.Sprintf("Imported tracks %s", getKST().Format("2006-01-02"))
+		}
+		newPlaylist, err := spotify.CreateNewPlaylist(name, trackListPlaylistDescription, false, options.UserID, options.AccessToken)
+		if err != nil {
+			slog.Error("Error creating the track list playlist", "userID", options.UserID, "name", name, "error", err)
+			return err
+		}
+		response.PlaylistID = newPlaylist.SpotifyPlaylistID
+		response.ExternalUrl = newPlaylist.URI
+	}
+
+	playlistOperation, err := playlistServer.addTracksToUserPlaylist(stream.Context(), options.UserID, response.PlaylistID, operationTrackListImport, uris, options.AccessToken)
+	if err != nil {
+		slog.Error("Error adding the track list to the playlist", "playlistID", response.PlaylistID, "error", err)
+		return fmt.Errorf("error adding the tracks to the playlist: %v", err)
+	}
+	response.Added = int32(len(uris))
+	response.OperationID = playlistOperation.ID
+	response.Status = fmt.Sprintf("Added %d of %d lines", response.Added, response.Total)
+
+	slog.Info("Imported track list", "userID", options.UserID, "playlistID", response.PlaylistID, "total", response.Total, "added", response.Added, "unmatched", response.Unmatched)
+
+	return stream.SendAndClose(response)
+}
+
+// matchTrackList searches the lines concurrently. Results are in the same order as the lines
+func (playlistServer *PlaylistServer) matchTrackList(ctx context.Context, lines []trackListLine, accessToken string) []*proto.ImportTrackListResult {
+	results := make([]*proto.ImportTrackListResult, len(lines))
+	// first line of each title and artist to skip searching the duplicates
+	seen := make(map[string]int32)
+	workers := make(chan struct{}, trackListSearchWorkers)
+
+	var wg sync.WaitGroup
+	for i, line := range lines {
+		key := strings.ToLower(line.title + "\x00" + line.artist)
+		if previous, ok := seen[key]; ok {
+			results[i] = &proto.ImportTrackListResult{
+				Line:   line.number,
+				Text:   line.text,
+				Title:  line.title,
+				Artist: line.artist,
+				Status: trackListStatusDuplicate,
+				Error:  fmt.Sprintf("same song as line %d", previous),
+			}
+			continue
+		}
+		seen[key] = line.number
+
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int, line trackListLine) {
+			defer wg.Done()
+			defer func() { <-workers }()
+			results[i] = playlistServer.matchTrackListLine(ctx, line, accessToken)
+		}(i, line)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// matchTrackListLine uses the search result when it's confident enough. Otherwise, the track resolved by the user is used
+func (playlistServer *PlaylistServer) matchTrackListLine(ctx context.Context, line trackListLine, accessToken string) *proto.ImportTrackListResult {
+	result := &proto.ImportTrackListResult{
+		Line:   line.number,
+		Text:   line.text,
+		Title:  line.title,
+		Artist: line.artist,
+	}
+
+	track, err := spotify.SearchTrack(line.title, line.artist, accessToken)
+	if err == nil && track != nil && track.URI != "" {
+		result.Confidence = spotify.MatchConfidence(line.title, line.artist, track)
+		if result.Confidence >= spotify.MatchReviewThreshold {
+			result.Status = trackListStatusMatched
+			result.Uri = track.URI
+			return result
+		}
+	}
+
+	resolvedTrack, resolvedErr := playlistServer.DB.GetResolvedTrack(ctx, database.GetResolvedTrackParams{
+		MissedTitle:  line.title,
+		MissedArtist: line.artist,
+	})
+	if resolvedErr == nil {
+		result.Status = trackListStatusResolved
+		result.Uri = resolvedTrack.Uri
+		result.Confidence = 1 // confirmed by the user when it was resolved
+		return result
+	}
+
+	if err != nil || track == nil || track.URI == "" {
+		result.Status = trackListStatusUnmatched
+		if err != nil {
+			result.Error = err.Error()
+		}
+		return result
+	}
+
+	slog.Info("Low confidence match of the track list line", "line", line.number, "text", line.text, "uri", track.URI, "confidence", result.Confidence)
+	result.Status = trackListStatusLowConfidence
+	result.CandidateTitle = track.Name
+	result.CandidateArtist = track.Artist
+	return result
+}
+
+// sortTrackListResults orders the results by the line number since the invalid lines are collected while receiving the stream
+func sortTrackListResults(results []*proto.ImportTrackListResult) []*proto.ImportTrackListResult {
+	sorted := make([]*proto.ImportTrackListResult, len(results))
+	copy(sorted, results)
+	slices.SortStableFunc(sorted, func(a, b *proto.ImportTrackListResult) int {
+		return cmp.Compare(a.Line, b.Line)
+	})
+	return sorted
+}